@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The type Argument represents a positional argument within the command line.
+// * The attribute "Name" contains the name of the argument. The name is used within the error messages and within the
+//   usage (ex: "<dest>"). It must be a valid long option name.
+// * The attribute "Holder" contains a pointer to the variable used to store the value of the argument. The types of
+//   value holders are the types used for options that take values (ex: *string, *int or *net.IP). The values are
+//   converted as if they were given to options.
+// * The attribute "Optional" indicates whether the argument may be omitted or not. Optional arguments must follow the
+//   required ones.
+// * The attribute "Variadic" indicates whether the argument collects all the remaining values or not. Only the last
+//   argument may be variadic, and its value holder must store a list of values (ex: *[]string).
+// * The attributes "MinCount" and "MaxCount" contain the minimum and the maximum numbers of values of a variadic
+//   argument. The value 0 means no limit. Please note that a variadic argument that is not optional requires at least
+//   one value.
+
+type Argument struct {
+	Name string          // The argument's name.
+	Holder interface{}   // Pointer to the argument's value holder.
+	Optional bool        // The flag that specifies whether the argument may be omitted or not.
+	Variadic bool        // The flag that specifies whether the argument collects the remaining values or not.
+	MinCount int         // The minimum number of values of a variadic argument.
+	MaxCount int         // The maximum number of values of a variadic argument (0 means no limit).
+}
+
+// The type Arguments represents the specification of the positional arguments. It is a directive: it is given to the
+// function Parse, after the specification of the options, and it is applied to the arguments once the command line has
+// been parsed.
+//
+// Example:
+//
+//     cli.Parse(os.Args[1:], spec, cli.Arguments{
+//         {Name: "src", Holder: &src},
+//         {Name: "files", Holder: &files, Variadic: true, Optional: true},
+//     })
+
+type Arguments []Argument
+
+// Return the option used to convert and store the values of an argument.
+
+func (a *Argument) getOption() *Option {
+	return &Option{Long: a.Name, Holder: a.Holder}
+}
+
+// Return the minimum number of values of an argument.
+
+func (a *Argument) getMinCount() int {
+	if ! a.Variadic {
+		if a.Optional { return 0 }
+		return 1
+	}
+	if ! a.Optional && a.MinCount < 1 { return 1 }
+	return a.MinCount
+}
+
+// Check that the specification of the positional arguments is valid, and return the options used to convert and store
+// their values:
+// - All arguments must have names and valid value holders.
+// - Only the last argument may be variadic. The value holder of a variadic argument must store a list of values. The
+//   value holders of the other arguments must store single values.
+// - Optional arguments must follow the required ones.
+// - Counts can only be specified for variadic arguments.
+
+func (s Arguments) init() ([]*Option, error) {
+	options := make([]*Option, len(s))
+	optional := false
+	for i := range s {
+		a := &s[i]
+		if "" == a.Name {
+			return nil, errors.New(fmt.Sprintf(errorArgumentNoName, i))
+		}
+		o := a.getOption()
+		if err := o.init(); nil != err {
+			return nil, errors.New(fmt.Sprintf(errorInvalidArgumentDefinition, a.Name, err.Error()))
+		}
+		if ! o.requireValue() || a.Variadic == o.isSingleton() {
+			return nil, errors.New(fmt.Sprintf(errorArgumentUnexpectedHolderType, a.Name))
+		}
+		if a.Variadic && i != len(s) - 1 {
+			return nil, errors.New(fmt.Sprintf(errorArgumentVariadicNotLast, a.Name))
+		}
+		if optional && ! a.Optional {
+			return nil, errors.New(fmt.Sprintf(errorArgumentRequiredAfterOptional, a.Name))
+		}
+		if ! a.Variadic && (0 != a.MinCount || 0 != a.MaxCount) {
+			return nil, errors.New(fmt.Sprintf(errorArgumentCountUnexpected, a.Name))
+		}
+		if a.MinCount < 0 || a.MaxCount < 0 || (a.MaxCount > 0 && a.getMinCount() > a.MaxCount) {
+			return nil, errors.New(fmt.Sprintf(errorInvalidArgumentCount, a.Name, a.MinCount, a.MaxCount))
+		}
+		optional = optional || a.Optional
+		options[i] = o
+	}
+	return options, nil
+}
+
+// Convert the arguments and store them within the arguments' value holders.
+
+func (s Arguments) check(inLine *parsedLine) error {
+	options, err := s.init()
+	if nil != err { return err }
+
+	args := inLine.args
+	for i := range s {
+		a := &s[i]
+		count := 1
+		if a.Variadic { count = len(args) }
+		if count > len(args) { count = len(args) }
+
+		if count < a.getMinCount() {
+			if 0 == count {
+				return errors.New(fmt.Sprintf(errorMissingArgument, a.Name))
+			}
+			return errors.New(fmt.Sprintf(errorTooFewArgumentValues, a.Name, a.getMinCount(), count))
+		}
+		if a.Variadic && a.MaxCount > 0 && count > a.MaxCount {
+			return errors.New(fmt.Sprintf(errorTooManyArgumentValues, a.Name, a.MaxCount, count))
+		}
+		for _, value := range args[:count] {
+			if err := options[i].addValue(value); nil != err {
+				return errors.New(fmt.Sprintf(errorInvalidArgumentValue, value, a.Name, err.Error()))
+			}
+		}
+		args = args[count:]
+	}
+
+	if 0 != len(args) {
+		return errors.New(fmt.Sprintf(errorUnexpectedArgument, args[0]))
+	}
+	return nil
+}
+
+// Return the representation of an argument within the synopsis of a command (ex: "<src>", "[<dst>]" or
+// "<files>...").
+
+func (a *Argument) getUsage() string {
+	usage := fmt.Sprintf(`<%s>`, a.Name)
+	if a.Variadic { usage += `...` }
+	if a.Optional { usage = fmt.Sprintf(`[%s]`, usage) }
+	return usage
+}
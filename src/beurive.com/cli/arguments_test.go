@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"reflect"
+)
+
+// -----------------------------------------------------------------
+// Test the positional arguments.
+// -----------------------------------------------------------------
+
+func TestArgumentsOk(t *testing.T)  {
+	var cloVerbose bool
+	var argSrc string
+	var argCount int
+	var argFiles []string
+
+	spec := Spec{
+		Option{Short: "v", Long: "verbose", Holder: &cloVerbose},
+	}
+	arguments := Arguments{
+		{Name: "src",   Holder: &argSrc},
+		{Name: "count", Holder: &argCount, Optional: true},
+		{Name: "files", Holder: &argFiles, Optional: true, Variadic: true, MaxCount: 3},
+	}
+
+	type testSet struct {
+		input []string
+		src string
+		count int
+		files []string
+	}
+
+	for i, set := range []testSet{
+		{input: []string{"a"}, src: "a", count: 0, files: []string{}},
+		{input: []string{"-v", "a", "10"}, src: "a", count: 10, files: []string{}},
+		{input: []string{"-v", "a", "10", "x", "y", "z"}, src: "a", count: 10, files: []string{"x", "y", "z"}},
+	} {
+		argSrc = ""
+		argCount = 0
+		argFiles = []string{}
+		if _, _, err := Parse(set.input, spec, arguments); nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+			continue
+		}
+		if set.src != argSrc || set.count != argCount || ! reflect.DeepEqual(set.files, argFiles) {
+			t.Errorf(`Test #%d failed! Unexpected values: "%s", %d, %v`, i, argSrc, argCount, argFiles)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_ArgumentsKo(t *testing.T)  {
+	type testSet struct {
+		arguments Arguments
+		input []string
+		expected string
+	}
+
+	var argSrc string
+	var argDst string
+	var argCount int
+	var argFlag bool
+	var argFiles []string
+	var argSizes []int
+
+	for i, set := range []testSet{
+		{arguments: Arguments{{Holder: &argSrc}},
+			expected: `Invalid argument definition: the argument at position 0 has no name.`},
+		{arguments: Arguments{{Name: "src", Holder: argSrc}},
+			expected: `Invalid argument definition for argument <src>: `},
+		{arguments: Arguments{{Name: "flag", Holder: &argFlag}},
+			expected: `Invalid argument definition for argument <flag>: unexpected value holder type`},
+		{arguments: Arguments{{Name: "files", Holder: &argFiles}},
+			expected: `Invalid argument definition for argument <files>: unexpected value holder type`},
+		{arguments: Arguments{{Name: "src", Holder: &argSrc, Variadic: true}},
+			expected: `Invalid argument definition for argument <src>: unexpected value holder type`},
+		{arguments: Arguments{{Name: "files", Holder: &argFiles, Variadic: true}, {Name: "dst", Holder: &argDst}},
+			expected: `Invalid argument definition for argument <files>: only the last argument can be variadic.`},
+		{arguments: Arguments{{Name: "src", Holder: &argSrc, Optional: true}, {Name: "dst", Holder: &argDst}},
+			expected: `Invalid argument definition for argument <dst>: a required argument cannot follow an optional one.`},
+		{arguments: Arguments{{Name: "src", Holder: &argSrc, MaxCount: 2}},
+			expected: `Invalid argument definition for argument <src>: counts can only be specified for variadic arguments.`},
+		{arguments: Arguments{{Name: "files", Holder: &argFiles, Variadic: true, MinCount: 3, MaxCount: 2}},
+			expected: `Invalid argument definition for argument <files>: invalid counts (minimum: 3, maximum: 2).`},
+		{arguments: Arguments{{Name: "src", Holder: &argSrc}, {Name: "dst", Holder: &argDst}},
+			input: []string{"a"},
+			expected: `Missing argument <dst>.`},
+		{arguments: Arguments{{Name: "files", Holder: &argFiles, Variadic: true}},
+			input: []string{},
+			expected: `Missing argument <files>.`},
+		{arguments: Arguments{{Name: "files", Holder: &argFiles, Variadic: true, MinCount: 2}},
+			input: []string{"a"},
+			expected: `The argument <files> requires at least 2 value(s) (found 1).`},
+		{arguments: Arguments{{Name: "files", Holder: &argFiles, Variadic: true, MaxCount: 2}},
+			input: []string{"a", "b", "c"},
+			expected: `The argument <files> accepts at most 2 value(s) (found 3).`},
+		{arguments: Arguments{{Name: "src", Holder: &argSrc}},
+			input: []string{"a", "b"},
+			expected: `Unexpected argument "b".`},
+		{arguments: Arguments{{Name: "count", Holder: &argCount}},
+			input: []string{"ten"},
+			expected: `Invalid value "ten" for argument <count>: strconv.ParseInt: parsing "ten": invalid syntax`},
+		{arguments: Arguments{{Name: "src", Holder: &argSrc}, {Name: "sizes", Holder: &argSizes, Variadic: true}},
+			input: []string{"a", "1", "x"},
+			expected: `Invalid value "x" for argument <sizes>: `},
+	} {
+		_, _, err := Parse(set.input, Spec{}, set.arguments)
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Return the base used to convert the values of an option which value holder stores arbitrary precision integers or
+// floats. By default, values are written in base 10.
+
+func (o *Option) getBigBase() int {
+	if 0 == o.Base { return 10 }
+	return o.Base
+}
+
+// Check that the base and the precision of an option are compatible with the type of the option's value holder:
+// - A base can only be specified for arbitrary precision integers (from 2 to 62) and floats (2, 8, 10 or 16).
+// - A precision can only be specified for arbitrary precision floats.
+
+func (o *Option) initBig() error {
+	t, _ := o.getType()
+	if 0 != o.Precision && TypeBigFloat != t && TypeBigFloats != t {
+		return errors.New(errorPrecisionUnexpectedHolderType)
+	}
+	if 0 == o.Base { return nil }
+
+	switch t {
+		case TypeBigInt, TypeBigInts:
+			if o.Base >= 2 && o.Base <= big.MaxBase { return nil }
+		case TypeBigFloat, TypeBigFloats:
+			switch o.Base {
+				case 2, 8, 10, 16: return nil
+			}
+		default:
+			return errors.New(errorBaseUnexpectedHolderType)
+	}
+	return errors.New(fmt.Sprintf(errorInvalidBase, o.Base))
+}
+
+// Convert a string into an arbitrary precision integer, written in the option's base.
+// The value is checked against the range of values allowed for the option.
+
+func (o *Option) parseBigInt(inValue string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(inValue, o.getBigBase())
+	if ! ok {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueBigIntExpected, o.displayValue(inValue), o.getName(), o.getBigBase()))
+	}
+	if err := o.checkRange(new(big.Float).SetInt(v), inValue); nil != err { return nil, err }
+	return v, nil
+}
+
+// Convert a string into an arbitrary precision float, written in the option's base.
+// The precision of the float (in bits) is the option's precision. The value 0 means 64 bits.
+// The value is checked against the range of values allowed for the option.
+
+func (o *Option) parseBigFloat(inValue string) (*big.Float, error) {
+	v, _, err := new(big.Float).SetPrec(o.Precision).Parse(inValue, o.getBigBase())
+	if nil != err {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueBigFloatExpected, o.displayValue(inValue), o.getName(), o.getBigBase()))
+	}
+	if err := o.checkRange(v, inValue); nil != err { return nil, err }
+	return v, nil
+}
+
+// Convert a string into an arbitrary precision rational number (ex: "3/4", "1.25" or "1e-3").
+// The value is checked against the range of values allowed for the option.
+
+func (o *Option) parseBigRat(inValue string) (*big.Rat, error) {
+	v, ok := new(big.Rat).SetString(inValue)
+	if ! ok {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueBigRatExpected, o.displayValue(inValue), o.getName()))
+	}
+	if err := o.checkRange(new(big.Float).SetPrec(256).SetRat(v), inValue); nil != err { return nil, err }
+	return v, nil
+}
+
+// Return a copy of an arbitrary precision number (*big.Int, *big.Float or *big.Rat). Please note that copying the
+// structures themselves would share their internal storage. Other values are returned as they are.
+
+func copyBig(inValue interface{}) interface{} {
+	switch v := inValue.(type) {
+		case *big.Int:
+			if nil != v { return new(big.Int).Set(v) }
+		case *big.Float:
+			if nil != v { return new(big.Float).Copy(v) }
+		case *big.Rat:
+			if nil != v { return new(big.Rat).Set(v) }
+	}
+	return inValue
+}
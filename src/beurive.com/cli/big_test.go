@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"math/big"
+)
+
+// -----------------------------------------------------------------
+// Test the arbitrary precision numbers.
+// -----------------------------------------------------------------
+
+func TestBigOk(t *testing.T)  {
+	var cloAmount big.Int
+	var cloRate big.Float
+	var cloRatio big.Rat
+	var cloKeys []*big.Int
+	var cloWeights []*big.Float
+	var cloShares []*big.Rat
+	var cloOptional *big.Int
+
+	spec := Spec{
+		Option{Short: "a", Long: "amount",   Holder: &cloAmount},
+		Option{Short: "r", Long: "rate",     Holder: &cloRate, Precision: 200},
+		Option{Short: "x", Long: "ratio",    Holder: &cloRatio, Min: 0, Max: 1},
+		Option{Short: "k", Long: "keys",     Holder: &cloKeys, Base: 16},
+		Option{Short: "w", Long: "weights",  Holder: &cloWeights},
+		Option{Short: "s", Long: "shares",   Holder: &cloShares},
+		Option{Short: "o", Long: "optional", Holder: &cloOptional},
+	}
+
+	input := []string{
+		"--amount", "123456789012345678901234567890",
+		"--rate", "0.1",
+		"--ratio", "3/4",
+		"-k", "DEADBEEFDEADBEEFDEADBEEF", "-k", "ff",
+		"-w", "1.5", "-w=-2e100",
+		"-s", "1/3",
+	}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if "123456789012345678901234567890" != cloAmount.String() {
+		t.Errorf(`Unexpected amount: %s.`, cloAmount.String())
+	}
+	if 200 != cloRate.Prec() || "0.1" != cloRate.Text('g', 10) {
+		t.Errorf(`Unexpected rate: %s (precision %d).`, cloRate.String(), cloRate.Prec())
+	}
+	if "3/4" != cloRatio.String() {
+		t.Errorf(`Unexpected ratio: %s.`, cloRatio.String())
+	}
+	if 2 != len(cloKeys) || "deadbeefdeadbeefdeadbeef" != cloKeys[0].Text(16) || 255 != cloKeys[1].Int64() {
+		t.Errorf(`Unexpected keys: %v.`, cloKeys)
+	}
+	if 2 != len(cloWeights) || "1.5" != cloWeights[0].String() || "-2e+100" != cloWeights[1].String() {
+		t.Errorf(`Unexpected weights: %v.`, cloWeights)
+	}
+	if 1 != len(cloShares) || "1/3" != cloShares[0].String() {
+		t.Errorf(`Unexpected shares: %v.`, cloShares)
+	}
+	if nil != cloOptional {
+		t.Errorf(`Unexpected optional value: %s.`, cloOptional.String())
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestBigNilHolderOk(t *testing.T)  {
+	// Nil value holders are allocated when the options appear within the command line.
+	spec := Spec{
+		Option{Long: "amount", Holder: (*big.Int)(nil)},
+		Option{Long: "rate",   Holder: (*big.Float)(nil)},
+		Option{Long: "ratio",  Holder: (*big.Rat)(nil)},
+	}
+	if _, _, err := Parse([]string{"--amount", "12", "--rate", "0.5", "--ratio", "1/2"}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if v, _ := spec.Lookup("amount").Holder.(*big.Int); nil == v || "12" != v.String() {
+		t.Errorf(`Unexpected amount: %v.`, spec.Lookup("amount").Holder)
+	}
+	if v, _ := spec.Lookup("rate").Holder.(*big.Float); nil == v || "0.5" != v.String() {
+		t.Errorf(`Unexpected rate: %v.`, spec.Lookup("rate").Holder)
+	}
+	if v, _ := spec.Lookup("ratio").Holder.(*big.Rat); nil == v || "1/2" != v.String() {
+		t.Errorf(`Unexpected ratio: %v.`, spec.Lookup("ratio").Holder)
+	}
+}
+
+func TestEM_BigKo(t *testing.T)  {
+	type testSet struct {
+		option Option
+		input []string
+		expected string
+	}
+
+	var cloAmount big.Int
+	var cloRate big.Float
+	var cloRatio big.Rat
+	var cloCount int
+
+	tests := []testSet{
+		{option: Option{Long: "count", Holder: &cloCount, Precision: 10},
+			expected: errorPrecisionUnexpectedHolderType},
+		{option: Option{Long: "amount", Holder: &cloAmount, Precision: 10},
+			expected: errorPrecisionUnexpectedHolderType},
+		{option: Option{Long: "count", Holder: &cloCount, Base: 16},
+			expected: errorBaseUnexpectedHolderType},
+		{option: Option{Long: "ratio", Holder: &cloRatio, Base: 16},
+			expected: errorBaseUnexpectedHolderType},
+		{option: Option{Long: "amount", Holder: &cloAmount, Base: 63},
+			expected: `Invalid option definition: invalid base (63).`},
+		{option: Option{Long: "rate", Holder: &cloRate, Base: 3},
+			expected: `Invalid option definition: invalid base (3).`},
+		{option: Option{Long: "amount", Holder: &cloAmount, Choices: []string{"1"}},
+			expected: errorChoicesUnexpectedHolderType},
+		{option: Option{Long: "amount", Holder: &cloAmount},
+			input: []string{"--amount", "1.5"},
+			expected: `Invalid value "1.5" for option "amount". Expected an integer (base 10).`},
+		{option: Option{Long: "amount", Holder: &cloAmount, Base: 2},
+			input: []string{"--amount", "102"},
+			expected: `Invalid value "102" for option "amount". Expected an integer (base 2).`},
+		{option: Option{Long: "rate", Holder: &cloRate},
+			input: []string{"--rate", "abc"},
+			expected: `Invalid value "abc" for option "rate". Expected a number (base 10).`},
+		{option: Option{Long: "ratio", Holder: &cloRatio},
+			input: []string{"--ratio", "3/0"},
+			expected: `Invalid value "3/0" for option "ratio". Expected a rational number (ex: 3/4 or 1.25).`},
+		{option: Option{Long: "ratio", Holder: &cloRatio, Min: 0, Max: 1},
+			input: []string{"--ratio", "5/4"},
+			expected: `Invalid value "5/4" for option "ratio". The value must be in the range [0, 1].`},
+		{option: Option{Long: "amount", Holder: &cloAmount, Max: big.NewInt(100), Secret: true},
+			input: []string{"--amount", "101"},
+			expected: `Invalid value "******" for option "amount". The value must be in the range (-inf, 100].`},
+	}
+
+	for i, set := range tests {
+		_, _, err := Parse(set.input, Spec{ set.option })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
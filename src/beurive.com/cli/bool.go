@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The words that represent boolean values, for flags given with attached values (ex: "--cache=false").
+// The comparison is case insensitive.
+
+var boolWords = map[string]bool{
+	`true`:  true,
+	`false`: false,
+	`yes`:   true,
+	`no`:    false,
+	`on`:    true,
+	`off`:   false,
+	`1`:     true,
+	`0`:     false,
+}
+
+// The words that represent boolean values, for flags which boolean syntax is strict.
+
+var strictBoolWords = map[string]bool{
+	`true`:  true,
+	`false`: false,
+}
+
+// Check that the strict boolean syntax only applies to flags.
+
+func (o *Option) initBool() error {
+	if ! o.StrictBool { return nil }
+	if t, _ := o.getType(); TypeBool != t {
+		return errors.New(errorStrictBoolUnexpectedHolderType)
+	}
+	return nil
+}
+
+// Convert a word into a boolean value (ex: "yes" gives true, "off" gives false).
+// If the option's boolean syntax is strict, then only the words "true" and "false" are accepted.
+
+func (o *Option) parseBool(inValue string) (bool, error) {
+	words, expected := boolWords, `true, false, yes, no, on, off, 1 or 0`
+	if o.StrictBool {
+		words, expected = strictBoolWords, `true or false`
+	}
+	if v, ok := words[strings.ToLower(inValue)]; ok {
+		return v, nil
+	}
+	return false, errors.New(fmt.Sprintf(errorInvalidValueBoolWordExpected, o.displayValue(inValue), o.getName(), expected))
+}
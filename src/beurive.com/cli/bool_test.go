@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+)
+
+// -----------------------------------------------------------------
+// Test the boolean values attached to flags.
+// -----------------------------------------------------------------
+
+func TestBoolOk(t *testing.T)  {
+	type testSet struct {
+		input string
+		strict bool
+		expected bool
+	}
+
+	tests := []testSet{
+		{input: "--cache",       expected: true},
+		{input: "--cache=true",  expected: true},
+		{input: "--cache=false", expected: false},
+		{input: "--cache=yes",   expected: true},
+		{input: "--cache=No",    expected: false},
+		{input: "--cache=ON",    expected: true},
+		{input: "--cache=off",   expected: false},
+		{input: "--cache=1",     expected: true},
+		{input: "--cache=0",     expected: false},
+		{input: "-c=false",      expected: false},
+		{input: "--cache=True",  expected: true,  strict: true},
+		{input: "--cache=false", expected: false, strict: true},
+	}
+
+	for i, set := range tests {
+		cloCache := ! set.expected
+		spec := Spec{ Option{Short: "c", Long: "cache", Holder: &cloCache, StrictBool: set.strict} }
+		if _, _, err := Parse([]string{set.input}, spec); nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+		} else if set.expected != cloCache {
+			t.Errorf(`Test #%d failed! Got %t, expected %t.`, i, cloCache, set.expected)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_BoolKo(t *testing.T)  {
+	type testSet struct {
+		option Option
+		input []string
+		expected string
+	}
+
+	var cloCache bool
+	var cloName string
+
+	tests := []testSet{
+		{option: Option{Long: "name", Holder: &cloName, StrictBool: true},
+			expected: errorStrictBoolUnexpectedHolderType},
+		{option: Option{Long: "cache", Holder: &cloCache},
+			input: []string{"--cache=maybe"},
+			expected: `Invalid value "maybe" for option "cache". Expected true, false, yes, no, on, off, 1 or 0.`},
+		{option: Option{Long: "cache", Holder: &cloCache},
+			input: []string{"--cache="},
+			expected: `Invalid value "" for option "cache". Expected true, false, yes, no, on, off, 1 or 0.`},
+		{option: Option{Long: "cache", Holder: &cloCache, StrictBool: true},
+			input: []string{"--cache=yes"},
+			expected: `Invalid value "yes" for option "cache". Expected true or false.`},
+		{option: Option{Long: "cache", Holder: &cloCache},
+			input: []string{"--cache=false", "--cache"},
+			expected: `Duplicated use of flag option "cache".`},
+	}
+
+	for i, set := range tests {
+		_, _, err := Parse(set.input, Spec{ set.option })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
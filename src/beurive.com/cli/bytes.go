@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// This type represents the encoding of the values of an option which value holder is a byte slice (*[]byte).
+// - EncodingNone: the byte slice is a list of unsigned 8-bit integers. Each occurrence of the option adds one integer
+//   to the list (ex: "--byte 1 --byte 255"). This is the default.
+// - EncodingHex: the value is a hexadecimal string (ex: "deadbeef").
+// - EncodingBase64: the value is a standard base64 string, with padding (ex: "3q2+7w==").
+// - EncodingBase64URL: the value is a URL-safe base64 string, with padding (ex: "3q2-7w==").
+// - EncodingRawBase64: the value is a standard base64 string, without padding (ex: "3q2+7w").
+// - EncodingRawBase64URL: the value is a URL-safe base64 string, without padding (ex: "3q2-7w").
+// - EncodingRaw: the bytes of the value are stored as they appear within the command line.
+//
+// Please note that, if the encoding is not EncodingNone, then the option can appear only once within the command line.
+
+type byteEncoding int
+
+const (
+	EncodingNone byteEncoding = iota
+	EncodingHex
+	EncodingBase64
+	EncodingBase64URL
+	EncodingRawBase64
+	EncodingRawBase64URL
+	EncodingRaw
+)
+
+// The descriptions of the encodings, used within the error messages.
+
+var encodingDescriptions = map[byteEncoding]string{
+	EncodingHex:          `a hexadecimal string (ex: deadbeef)`,
+	EncodingBase64:       `a base64 string (ex: 3q2+7w==)`,
+	EncodingBase64URL:    `a URL-safe base64 string (ex: 3q2-7w==)`,
+	EncodingRawBase64:    `an unpadded base64 string (ex: 3q2+7w)`,
+	EncodingRawBase64URL: `an unpadded URL-safe base64 string (ex: 3q2-7w)`,
+	EncodingRaw:          `a string`,
+}
+
+// Check that the encoding of an option is compatible with the type of the option's value holder:
+// - Encodings apply to byte slices (*[]byte) only.
+// - The exact length of the decoded value can only be specified if the option has an encoding.
+
+func (o *Option) initBytes() error {
+	if EncodingNone == o.Encoding {
+		if 0 != o.ByteLength {
+			return errors.New(errorByteLengthWithoutEncoding)
+		}
+		return nil
+	}
+
+	if _, ok := encodingDescriptions[o.Encoding]; ! ok {
+		return errors.New(fmt.Sprintf(errorInvalidEncoding, o.Encoding))
+	}
+	if t, _ := o.getType(); TypeBytes != t {
+		return errors.New(errorEncodingUnexpectedHolderType)
+	}
+	if o.ByteLength < 0 {
+		return errors.New(fmt.Sprintf(errorInvalidByteLength, o.ByteLength))
+	}
+	return nil
+}
+
+// Decode a value according to the option's encoding.
+// If the option requires an exact length, then the number of decoded bytes is checked.
+
+func (o *Option) decodeBytes(inValue string) ([]byte, error) {
+	var data []byte
+	var err error
+
+	switch o.Encoding {
+		case EncodingHex:
+			data, err = hex.DecodeString(inValue)
+		case EncodingBase64:
+			data, err = base64.StdEncoding.DecodeString(inValue)
+		case EncodingBase64URL:
+			data, err = base64.URLEncoding.DecodeString(inValue)
+		case EncodingRawBase64:
+			data, err = base64.RawStdEncoding.DecodeString(inValue)
+		case EncodingRawBase64URL:
+			data, err = base64.RawURLEncoding.DecodeString(inValue)
+		default:
+			data = []byte(inValue)
+	}
+	if nil != err {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueEncodingExpected, o.displayValue(inValue), o.getName(), encodingDescriptions[o.Encoding]))
+	}
+
+	if o.ByteLength > 0 && len(data) != o.ByteLength {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueByteLength, o.displayValue(inValue), o.getName(), o.ByteLength, len(data)))
+	}
+	return data, nil
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"testing"
+	"bytes"
+	"strings"
+)
+
+// -----------------------------------------------------------------
+// Test the decoding of byte slices.
+// -----------------------------------------------------------------
+
+func TestBytesOk(t *testing.T)  {
+	type testSet struct {
+		encoding byteEncoding
+		length int
+		input string
+		expected []byte
+	}
+
+	tests := []testSet{
+		{encoding: EncodingHex,          input: "deadbeef", expected: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{encoding: EncodingHex,          input: "DEADBEEF", expected: []byte{0xde, 0xad, 0xbe, 0xef}, length: 4},
+		{encoding: EncodingBase64,       input: "3q2+7w==", expected: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{encoding: EncodingBase64URL,    input: "3q2-7w==", expected: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{encoding: EncodingRawBase64,    input: "3q2+7w",   expected: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{encoding: EncodingRawBase64URL, input: "3q2-7w",   expected: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{encoding: EncodingRaw,          input: "salt",     expected: []byte("salt"), length: 4},
+		{encoding: EncodingHex,          input: "",         expected: []byte{}},
+	}
+
+	for i, set := range tests {
+		var cloKey []byte
+		spec := Spec{ Option{Short: "k", Long: "key", Holder: &cloKey, Encoding: set.encoding, ByteLength: set.length} }
+		if _, _, err := Parse([]string{"--key", set.input}, spec); nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+			continue
+		}
+		if ! bytes.Equal(set.expected, cloKey) {
+			t.Errorf(`Test #%d failed! Got %v, expected %v.`, i, cloKey, set.expected)
+		}
+	}
+
+	// Without encoding, a byte slice is still a list of integers.
+	var cloBytes []byte
+	spec := Spec{ Option{Short: "b", Long: "byte", Holder: &cloBytes} }
+	if _, _, err := Parse([]string{"-b", "1", "--byte", "255"}, spec); nil != err || ! bytes.Equal([]byte{1, 255}, cloBytes) {
+		t.Errorf(`Unexpected result: %v, %v.`, cloBytes, err)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestBytesNilHolderOk(t *testing.T)  {
+	// A nil value holder is allocated when the option appears within the command line.
+	spec := Spec{ Option{Long: "key", Holder: (*[]byte)(nil), Encoding: EncodingHex} }
+	if _, _, err := Parse([]string{"--key", "deadbeef"}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if p, _ := spec.Lookup("key").Holder.(*[]byte); nil == p || ! bytes.Equal([]byte{0xde, 0xad, 0xbe, 0xef}, *p) {
+		t.Errorf(`Unexpected value: %v.`, spec.Lookup("key").Holder)
+	}
+}
+
+func TestEM_BytesKo(t *testing.T)  {
+	type testSet struct {
+		option Option
+		input []string
+		expected string
+	}
+
+	var cloKey []byte
+	var cloString string
+
+	tests := []testSet{
+		{option: Option{Long: "key", Holder: &cloString, Encoding: EncodingHex},
+			expected: errorEncodingUnexpectedHolderType},
+		{option: Option{Long: "key", Holder: &cloKey, Encoding: byteEncoding(99)},
+			expected: `Invalid option definition: unknown encoding (99).`},
+		{option: Option{Long: "key", Holder: &cloKey, ByteLength: 4},
+			expected: errorByteLengthWithoutEncoding},
+		{option: Option{Long: "key", Holder: &cloKey, Encoding: EncodingHex, ByteLength: -1},
+			expected: `Invalid option definition: invalid length in bytes (-1).`},
+		{option: Option{Long: "key", Holder: &cloKey, Encoding: EncodingHex},
+			input: []string{"--key", "xyz"},
+			expected: `Invalid value "xyz" for option "key". Expected a hexadecimal string (ex: deadbeef).`},
+		{option: Option{Long: "key", Holder: &cloKey, Encoding: EncodingBase64},
+			input: []string{"--key", "3q2+7w"},
+			expected: `Invalid value "3q2+7w" for option "key". Expected a base64 string (ex: 3q2+7w==).`},
+		{option: Option{Long: "key", Holder: &cloKey, Encoding: EncodingHex, ByteLength: 16},
+			input: []string{"--key", "deadbeef"},
+			expected: `Invalid value "deadbeef" for option "key". The decoded value must contain exactly 16 bytes (got 4).`},
+		{option: Option{Long: "key", Holder: &cloKey, Encoding: EncodingHex, Secret: true},
+			input: []string{"--key", "xyz"},
+			expected: `Invalid value "******" for option "key". Expected a hexadecimal string (ex: deadbeef).`},
+		{option: Option{Long: "key", Holder: &cloKey, Encoding: EncodingHex},
+			input: []string{"--key", "00", "--key", "11"},
+			expected: `Duplicated use of non flag option "key".`},
+	}
+
+	for i, set := range tests {
+		_, _, err := Parse(set.input, Spec{ set.option })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Check that the list of values allowed for an option is compatible with the type of the option's value holder.
+// - Lists of allowed values apply to strings and integers only.
+// - If the option's value holder stores integers, then all the allowed values must be integers (written according to
+//   the option's numeric syntax).
+
+func (o *Option) initChoices() error {
+	if 0 == len(o.Choices) { return nil }
+
+	switch o.getFamily() {
+		case familyString:
+			return nil
+		case familySigned:
+			for _, c := range o.Choices {
+				if _, err := strconv.ParseInt(c, o.getBase(), 64); nil != err {
+					return errors.New(fmt.Sprintf(errorInvalidIntegerChoice, c))
+				}
+			}
+			return nil
+		case familyUnsigned:
+			for _, c := range o.Choices {
+				if _, err := strconv.ParseUint(c, o.getBase(), 64); nil != err {
+					return errors.New(fmt.Sprintf(errorInvalidIntegerChoice, c))
+				}
+			}
+			return nil
+	}
+	return errors.New(errorChoicesUnexpectedHolderType)
+}
+
+// Test whether a given choice matches a given value.
+// Integers are compared by value, according to the option's numeric syntax (ex: "010" matches "10" and, using the GO
+// syntax, "0xa" matches "10"). Strings are compared according to the option's attribute "IgnoreCase".
+
+func (o *Option) matchChoice(inChoice string, inValue string) bool {
+	switch o.getFamily() {
+		case familySigned:
+			c, _ := strconv.ParseInt(inChoice, o.getBase(), 64)
+			v, err := strconv.ParseInt(inValue, o.getBase(), 64)
+			return nil == err && c == v
+		case familyUnsigned:
+			c, _ := strconv.ParseUint(inChoice, o.getBase(), 64)
+			v, err := strconv.ParseUint(inValue, o.getBase(), 64)
+			return nil == err && c == v
+	}
+	if o.IgnoreCase {
+		return strings.EqualFold(inChoice, inValue)
+	}
+	return inChoice == inValue
+}
+
+// Check that a value is one of the values allowed for an option.
+// If the value is allowed, then the function returns the value that must be stored within the option's value holder.
+// Please note that, if the comparison is case insensitive, then this value is the allowed value as written within
+// the specification. Otherwise, the function returns an error that lists the allowed values.
+
+func (o *Option) checkChoice(inValue string) (string, error) {
+	if 0 == len(o.Choices) { return inValue, nil }
+
+	for _, c := range o.Choices {
+		if o.matchChoice(c, inValue) {
+			if familyString == o.getFamily() { return c, nil }
+			return inValue, nil
+		}
+	}
+	return "", errors.New(fmt.Sprintf(errorInvalidValueNotAllowed, o.displayValue(inValue), o.getName(), strings.Join(o.Choices, `, `)))
+}
+
+// Return the list of allowed values that start with a given prefix.
+// This function is intended to be used by shell completion scripts and help generators. If no list of allowed values
+// is specified for the option, then the function returns an empty list.
+
+func (o *Option) CompleteValue(inPrefix string) []string {
+	res := make([]string, 0)
+	for _, c := range o.Choices {
+		if len(c) < len(inPrefix) { continue }
+		if o.IgnoreCase && strings.EqualFold(c[:len(inPrefix)], inPrefix) {
+			res = append(res, c)
+		} else if strings.HasPrefix(c, inPrefix) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+)
+
+// -----------------------------------------------------------------
+// Test the restriction of the values to a list of allowed values.
+// -----------------------------------------------------------------
+
+func TestChoicesOk(t *testing.T)  {
+	var cloFormat string
+	var cloLevel int
+	var cloTags []string
+	var cloPorts []uint16
+
+	spec := Spec{
+		Option{Short: "f", Long: "format", Holder: &cloFormat, Choices: []string{"json", "yaml", "text"}, IgnoreCase: true},
+		Option{Short: "l", Long: "level",  Holder: &cloLevel,  Choices: []string{"0", "1", "2"}},
+		Option{Short: "t", Long: "tag",    Holder: &cloTags,   Choices: []string{"a", "b"}},
+		Option{Short: "p", Long: "port",   Holder: &cloPorts,  Choices: []string{"80", "443"}},
+	}
+
+	input := []string{"--format", "YAML", "-l", "02", "-t", "b", "-t", "a", "-p", "443"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Errorf(`Unexpected error: %s`, err.Error())
+	}
+	if "yaml" != cloFormat {
+		t.Errorf(`Unexpected value "%s". Expected "yaml".`, cloFormat)
+	}
+	if 2 != cloLevel {
+		t.Errorf(`Unexpected value %d. Expected 2.`, cloLevel)
+	}
+	if 2 != len(cloTags) || "b" != cloTags[0] || "a" != cloTags[1] {
+		t.Errorf(`Unexpected values %v.`, cloTags)
+	}
+	if 1 != len(cloPorts) || 443 != cloPorts[0] {
+		t.Errorf(`Unexpected values %v.`, cloPorts)
+	}
+}
+
+func TestCompleteValue(t *testing.T)  {
+	var cloFormat string
+	var cloMode string
+
+	type setType struct {
+		option   Option
+		prefix   string
+		expected []string
+	}
+
+	testSet := []setType{
+		{ option: Option{Long: "format", Holder: &cloFormat, Choices: []string{"json", "jsonl", "yaml"}}, prefix: "js", expected: []string{"json", "jsonl"} },
+		{ option: Option{Long: "format", Holder: &cloFormat, Choices: []string{"json", "jsonl", "yaml"}}, prefix: "", expected: []string{"json", "jsonl", "yaml"} },
+		{ option: Option{Long: "format", Holder: &cloFormat, Choices: []string{"json", "jsonl", "yaml"}}, prefix: "JS", expected: []string{} },
+		{ option: Option{Long: "mode", Holder: &cloMode, Choices: []string{"Fast", "Slow"}, IgnoreCase: true}, prefix: "f", expected: []string{"Fast"} },
+		{ option: Option{Long: "mode", Holder: &cloMode}, prefix: "f", expected: []string{} },
+	}
+
+	for i, set := range testSet {
+		got := set.option.CompleteValue(set.prefix)
+		if strings.Join(got, ",") != strings.Join(set.expected, ",") {
+			t.Errorf(`Test #%d failed. Got %v, expected %v.`, i, got, set.expected)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValueNotAllowed(t *testing.T)  {
+	var cloFormat string
+	var cloLevel int
+
+	type setType struct {
+		spec Spec
+		input []string
+		expected string
+	}
+	testSet := []setType{
+		{
+			spec: Spec{
+				Option{Short: "f", Long: "format", Holder: &cloFormat, Choices: []string{"json", "yaml"}},
+			},
+			input: []string{ "--format", "YAML" },
+			expected: fmt.Sprintf(errorInvalidValueNotAllowed, "YAML", "format", "json, yaml"),
+		},
+		{
+			spec: Spec{
+				Option{Short: "l", Long: "", Holder: &cloLevel, Choices: []string{"1", "2"}},
+			},
+			input: []string{ "-l", "3" },
+			expected: fmt.Sprintf(errorInvalidValueNotAllowed, "3", "l", "1, 2"),
+		},
+	}
+
+	for i, set := range testSet {
+		if _, _, err := Parse(set.input, set.spec); nil == err {
+			t.Errorf(`The test number %d should NOT be OK (%s)`, i, strings.Join(set.input, " "))
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
+
+func TestEM_ChoicesUnexpectedHolderType(t *testing.T)  {
+	var cloRatio float64
+
+	o := Option{Short: "r", Long: "ratio", Holder: &cloRatio, Choices: []string{"0.5"}}
+	if err := o.init(); nil == err {
+		t.Error("Option's specifier should not be valid!")
+	} else {
+		if 0 != strings.Compare(err.Error(), errorChoicesUnexpectedHolderType) {
+			t.Errorf(`Test failed. Got [%s] / [%s]`, err.Error(), errorChoicesUnexpectedHolderType)
+		}
+	}
+}
+
+func TestEM_InvalidIntegerChoice(t *testing.T)  {
+	var cloLevel uint
+
+	o := Option{Short: "l", Long: "level", Holder: &cloLevel, Choices: []string{"1", "-2"}}
+	if err := o.init(); nil == err {
+		t.Error("Option's specifier should not be valid!")
+	} else {
+		m := fmt.Sprintf(errorInvalidIntegerChoice, "-2")
+		if 0 != strings.Compare(err.Error(), m) {
+			t.Errorf(`Test failed. Got [%s] / [%s]`, err.Error(), m)
+		}
+	}
+}
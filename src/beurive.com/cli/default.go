@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Store the default value of an option within the option's value holder. The default value may be:
+// - a string, which is interpreted as if it was given within the command line (ex: "10" for an integer).
+// - a list of strings, for options that can appear more than once. Each string is interpreted as if it was given
+//   within the command line.
+// - a value which type is the type of the variable pointed by the value holder (ex: 10 for an integer, or []int{1, 2}
+//   for a list of integers), or a pointer to such a value (ex: a *big.Int for a big.Int). Flags and numbers are
+//   interpreted as if they were given within the command line. The other values are checked against the range of
+//   values allowed for the option (if any) and against the option's validator.
+// Please note that the default value does not set the option: the option is set only if it appears within the
+// command line. For options which value holders store files (*os.File), a default path is only recorded: the file is
+// opened once the command line has been parsed, and only if no other path is given (see the function openFiles).
+
+func (o *Option) applyDefault() error {
+	if nil == o.Default { return nil }
+
+	switch d := o.Default.(type) {
+		case string:
+			if err := o.addValue(d); nil != err {
+				return errors.New(fmt.Sprintf(errorInvalidDefault, err.Error()))
+			}
+		case []string:
+			if o.isSingleton() && 1 != len(d) {
+				return errors.New(errorDefaultUnexpectedList)
+			}
+			for _, v := range d {
+				if err := o.addValue(v); nil != err {
+					return errors.New(fmt.Sprintf(errorInvalidDefault, err.Error()))
+				}
+			}
+		default:
+			holder := reflect.ValueOf(o.Holder).Elem()
+			if o.isIndirect() {
+				holder = reflect.ValueOf(o.getIndirectHolder()).Elem()
+			}
+			value := reflect.ValueOf(o.Default)
+			if reflect.Ptr == value.Kind() && ! value.IsNil() && ! value.Type().AssignableTo(holder.Type()) {
+				value = value.Elem()
+			}
+			if ! value.Type().AssignableTo(holder.Type()) {
+				return errors.New(fmt.Sprintf(errorDefaultUnexpectedType, o.Default))
+			}
+			t, _ := o.getType()
+			f := o.getFamily()
+			if KindJSON != o.Kind && (TypeBool == t || familySigned == f || familyUnsigned == f || familyFloat == f) {
+				// Flags and numbers are stored as if they were given within the command line (thus, the allowed values,
+				// the ranges of values and the validators apply). Numbers are written in base 10, which is valid for all
+				// numeric syntaxes.
+				elements := []reflect.Value{value}
+				if ! o.isSingleton() {
+					elements = make([]reflect.Value, value.Len())
+					for i := range elements { elements[i] = value.Index(i) }
+				}
+				for _, e := range elements {
+					if err := o.addValue(fmt.Sprint(e.Interface())); nil != err {
+						return errors.New(fmt.Sprintf(errorInvalidDefault, err.Error()))
+					}
+				}
+				break
+			}
+
+			if reflect.Slice == value.Kind() {
+				// Copy the list, so that the values given by the user are not appended to the default list.
+				value = reflect.AppendSlice(reflect.MakeSlice(holder.Type(), 0, value.Len()), value)
+			}
+			if familyBig == f {
+				// Copy the numbers, so that the default value does not share its storage with the value holder.
+				if o.isSingleton() {
+					v := reflect.New(value.Type())
+					v.Elem().Set(value)
+					value = reflect.ValueOf(copyBig(v.Interface())).Elem()
+				} else {
+					for i := 0; i < value.Len(); i++ {
+						value.Index(i).Set(reflect.ValueOf(copyBig(value.Index(i).Interface())))
+					}
+				}
+			}
+			holder.Set(value)
+
+			// The other values are stored as they are. Check them against the range of values allowed for the option
+			// and against the option's validator.
+			elements := []interface{}{holder.Interface()}
+			if familyBig == f || familyRegexp == f {
+				elements[0] = holder.Addr().Interface()
+			}
+			if ! o.isSingleton() {
+				elements = make([]interface{}, value.Len())
+				for i := range elements { elements[i] = value.Index(i).Interface() }
+			}
+			for _, e := range elements {
+				if err := o.checkDefaultValue(e); nil != err {
+					return errors.New(fmt.Sprintf(errorInvalidDefault, err.Error()))
+				}
+			}
+	}
+	o.defaulted = true
+	return nil
+}
+
+// Check a value stored within an option's value holder by the option's default value against the range of values
+// allowed for the option (for arbitrary precision numbers) and against the option's validator.
+
+func (o *Option) checkDefaultValue(inValue interface{}) error {
+	text := fmt.Sprint(inValue)
+	if n, ok := toBigFloat(inValue); ok && familyBig == o.getFamily() {
+		if err := o.checkRange(n, text); nil != err { return err }
+	}
+	if nil == o.Validate { return nil }
+
+	if err := o.Validate(inValue); nil != err {
+		return &OptionError{Name: o.getName(), Value: o.displayValue(text), Err: err}
+	}
+	return nil
+}
+
+// Remove the default value of an option from the option's value holder, before the first value given within the
+// command line is stored. Thus, the values given by the user replace the default list of values (instead of being
+// appended to it).
+
+func (o *Option) clearDefault() {
+	o.defaulted = false
+	o.values = nil
+	o.path = ""
+	v := reflect.ValueOf(o.Holder).Elem()
+	if o.isIndirect() {
+		v.Set(reflect.Zero(v.Type()))
+	} else if ! o.isSingleton() && reflect.Slice == v.Kind() {
+		v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+	}
+}
+
+// Return the textual representation of the default value of an option (ex: for help messages).
+// If the option has no default value, then the function returns an empty string. If the option is secret, then the
+// function returns the mask.
+
+func (o *Option) DefaultString() string {
+	if nil == o.Default { return "" }
+
+	var value string
+	switch d := o.Default.(type) {
+		case string:
+			value = d
+		case []string:
+			value = strings.Join(d, `,`)
+		case fmt.Stringer:
+			value = d.String()
+		default:
+			v := reflect.ValueOf(d)
+			if reflect.Slice == v.Kind() {
+				elements := make([]string, v.Len())
+				for i := 0; i < v.Len(); i++ {
+					elements[i] = fmt.Sprintf(`%v`, v.Index(i).Interface())
+				}
+				value = strings.Join(elements, `,`)
+			} else {
+				value = fmt.Sprintf(`%v`, d)
+			}
+	}
+	return o.displayValue(value)
+}
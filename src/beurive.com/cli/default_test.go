@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"testing"
+	"errors"
+	"strings"
+	"math/big"
+	"os"
+	"path/filepath"
+)
+
+// -----------------------------------------------------------------
+// Test the default values.
+// -----------------------------------------------------------------
+
+func TestDefaultOk(t *testing.T)  {
+	var cloRetries int
+	var cloTimeout int
+	var cloCache bool
+	var cloTags []string
+	var cloPorts []int
+	var cloLevels []uint8
+	var cloLimit *int
+	var cloAmount big.Int
+
+	spec := Spec{
+		Option{Short: "r", Long: "retries", Holder: &cloRetries, Default: 3},
+		Option{Short: "t", Long: "timeout", Holder: &cloTimeout, Default: "30"},
+		Option{Short: "c", Long: "cache",   Holder: &cloCache,   Default: true},
+		Option{Long: "tags",                Holder: &cloTags,    Default: []string{"a", "b"}},
+		Option{Short: "p", Long: "ports",   Holder: &cloPorts,   Default: []int{80, 443}},
+		Option{Short: "l", Long: "levels",  Holder: &cloLevels,  Default: "1"},
+		Option{Long: "limit",               Holder: &cloLimit,   Default: 5},
+		Option{Short: "a", Long: "amount",  Holder: &cloAmount,  Default: big.NewInt(42)},
+	}
+
+	// Default values only.
+	if _, _, err := Parse([]string{}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if 3 != cloRetries || 30 != cloTimeout || ! cloCache || "a b" != strings.Join(cloTags, " ") ||
+		2 != len(cloPorts) || 443 != cloPorts[1] || 1 != len(cloLevels) || 1 != cloLevels[0] ||
+		nil == cloLimit || 5 != *cloLimit || 42 != cloAmount.Int64() {
+		t.Errorf(`Unexpected values: %d, %d, %t, %v, %v, %v, %v, %s.`, cloRetries, cloTimeout, cloCache, cloTags, cloPorts, cloLevels, cloLimit, cloAmount.String())
+	}
+	for _, option := range spec {
+		if option.IsSet() {
+			t.Errorf(`The option "%s" should not be set.`, option.getName())
+		}
+	}
+
+	// Values given within the command line replace the default values.
+	input := []string{"-r", "0", "--cache=false", "--tags", "c", "-p", "8080", "-p", "8443", "-l", "2", "--limit", "7", "-a", "1"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if 0 != cloRetries || 30 != cloTimeout || cloCache || "c" != strings.Join(cloTags, " ") ||
+		2 != len(cloPorts) || 8080 != cloPorts[0] || 1 != len(cloLevels) || 2 != cloLevels[0] ||
+		nil == cloLimit || 7 != *cloLimit || 1 != cloAmount.Int64() {
+		t.Errorf(`Unexpected values: %d, %d, %t, %v, %v, %v, %v, %s.`, cloRetries, cloTimeout, cloCache, cloTags, cloPorts, cloLevels, cloLimit, cloAmount.String())
+	}
+	if ! spec.Lookup("retries").IsSet() || spec.Lookup("timeout").IsSet() {
+		t.Error(`Unexpected states.`)
+	}
+}
+
+func TestDefaultBigOk(t *testing.T)  {
+	var cloAmount big.Int
+	var cloRate big.Float
+	var cloRatio big.Rat
+	var cloAmounts []*big.Int
+
+	amount := big.NewInt(123456789)
+	rate := big.NewFloat(1.5)
+	ratio := big.NewRat(3, 4)
+	amounts := []*big.Int{big.NewInt(10), big.NewInt(20)}
+
+	spec := Spec{
+		Option{Long: "amount",  Holder: &cloAmount,  Default: amount},
+		Option{Long: "rate",    Holder: &cloRate,    Default: rate},
+		Option{Long: "ratio",   Holder: &cloRatio,   Default: ratio},
+		Option{Long: "amounts", Holder: &cloAmounts, Default: amounts},
+	}
+
+	// The default values must not be modified by the values given within the command line.
+	if _, _, err := Parse([]string{}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	cloAmounts[0].SetInt64(30)
+	input := []string{"--amount", "5", "--rate", "2.25", "--ratio", "1/3"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if 5 != cloAmount.Int64() || "2.25" != cloRate.String() || "1/3" != cloRatio.String() {
+		t.Errorf(`Unexpected values: %s, %s, %s.`, cloAmount.String(), cloRate.String(), cloRatio.String())
+	}
+	if 123456789 != amount.Int64() || "1.5" != rate.String() || "3/4" != ratio.String() || 10 != amounts[0].Int64() {
+		t.Errorf(`Unexpected default values: %s, %s, %s, %s.`, amount.String(), rate.String(), ratio.String(), amounts[0].String())
+	}
+}
+
+func TestDefaultFileOk(t *testing.T)  {
+	dir := t.TempDir()
+	keep := filepath.Join(dir, "keep.txt")
+	other := filepath.Join(dir, "other.txt")
+	if err := os.WriteFile(keep, []byte("data"), 0644); nil != err {
+		t.Fatal(err)
+	}
+
+	var cloOutput *os.File
+	spec := Spec{ Option{Long: "out", Holder: &cloOutput, PathFlags: PathMustBeWritable, Default: keep} }
+
+	// The default file is not opened if another path is given.
+	if _, _, err := Parse([]string{"--out", other}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if nil == cloOutput || other != cloOutput.Name() {
+		t.Errorf(`Unexpected file: %v.`, cloOutput)
+	}
+	cloOutput.Close()
+	if b, err := os.ReadFile(keep); nil != err || "data" != string(b) {
+		t.Errorf(`The file "%s" should not be modified.`, keep)
+	}
+
+	// The default file is opened if no path is given.
+	if _, _, err := Parse([]string{}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if nil == cloOutput || keep != cloOutput.Name() {
+		t.Errorf(`Unexpected file: %v.`, cloOutput)
+	}
+	cloOutput.Close()
+}
+
+func TestDefaultStringOk(t *testing.T)  {
+	var cloString string
+	var cloInts []int
+
+	type testSet struct {
+		option Option
+		expected string
+	}
+
+	for i, set := range []testSet{
+		{option: Option{Long: "name", Holder: &cloString}, expected: ""},
+		{option: Option{Long: "name", Holder: &cloString, Default: "guest"}, expected: "guest"},
+		{option: Option{Long: "name", Holder: &cloString, Default: "guest", Secret: true}, expected: secretMask},
+		{option: Option{Long: "ports", Holder: &cloInts, Default: []int{80, 443}}, expected: "80,443"},
+		{option: Option{Long: "ports", Holder: &cloInts, Default: []string{"80", "443"}}, expected: "80,443"},
+		{option: Option{Long: "amount", Holder: &cloInts, Default: big.NewInt(10)}, expected: "10"},
+	} {
+		if got := set.option.DefaultString(); set.expected != got {
+			t.Errorf(`Test #%d failed! Got "%s", expected "%s".`, i, got, set.expected)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_DefaultKo(t *testing.T)  {
+	type testSet struct {
+		option Option
+		expected string
+	}
+
+	var cloCount int
+	var cloCounts []int
+	var cloLevel int8
+	var cloVerbose bool
+	var cloAmount big.Int
+	var cloAmounts []*big.Int
+
+	rejectAll := func(inValue interface{}) error { return errors.New("rejected") }
+
+	tests := []testSet{
+		{option: Option{Long: "count", Holder: &cloCount, Default: "ten"},
+			expected: `Invalid option definition: invalid default value. strconv.ParseInt: parsing "ten": invalid syntax`},
+		{option: Option{Long: "count", Holder: &cloCount, Default: "20", Max: 10},
+			expected: `Invalid option definition: invalid default value. Invalid value "20" for option "count". The value must be in the range (-inf, 10].`},
+		{option: Option{Long: "count", Holder: &cloCount, Default: []string{"1", "2"}},
+			expected: errorDefaultUnexpectedList},
+		{option: Option{Long: "count", Holder: &cloCount, Default: 1.5},
+			expected: `Invalid option definition: the default value (1.5) is not compatible with the value holder.`},
+		{option: Option{Long: "level", Holder: &cloLevel, Default: 1},
+			expected: `Invalid option definition: the default value (1) is not compatible with the value holder.`},
+		{option: Option{Long: "counts", Holder: &cloCounts, Default: []string{"1", "x"}},
+			expected: `Invalid option definition: invalid default value.`},
+		{option: Option{Long: "count", Holder: &cloCount, Default: 5, Choices: []string{"1", "2"}},
+			expected: `Invalid option definition: invalid default value. Invalid value "5" for option "count". Allowed values are: 1, 2.`},
+		{option: Option{Long: "counts", Holder: &cloCounts, Default: []int{1, 20}, Max: 10},
+			expected: `Invalid option definition: invalid default value. Invalid value "20" for option "counts". The value must be in the range (-inf, 10].`},
+		{option: Option{Long: "verbose", Holder: &cloVerbose, Default: true, Validate: rejectAll},
+			expected: `Invalid option definition: invalid default value. Invalid value "true" for option "verbose": rejected`},
+		{option: Option{Long: "amount", Holder: &cloAmount, Default: big.NewInt(20), Max: 10},
+			expected: `Invalid option definition: invalid default value. Invalid value "20" for option "amount". The value must be in the range (-inf, 10].`},
+		{option: Option{Long: "amounts", Holder: &cloAmounts, Default: []*big.Int{big.NewInt(1)}, Validate: rejectAll},
+			expected: `Invalid option definition: invalid default value. Invalid value "1" for option "amounts": rejected`},
+	}
+
+	for i, set := range tests {
+		_, _, err := Parse([]string{}, Spec{ set.option })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package cli
+
+// The type Directive represents an element of the command line specification that does not apply to a single option.
+// Directives are given to the function Parse, after the specification. They are applied once all the options have
+// been parsed.
+
+type Directive interface {
+	check(inLine *parsedLine) error
+}
+
+// This structure represents a parsed command line, as given to the directives.
+// * The attribute "spec" contains the specification, which gives access to the values of all the options.
+// * The attribute "args" contains the arguments.
+
+type parsedLine struct {
+	spec Spec       // The specification.
+	args []string   // The arguments.
+}
+
+// The type Validator represents a post-parse validation hook. The hook is called once all the options have been parsed.
+// It receives the specification, which gives access to the values of all the options (see Spec.Lookup). It returns an
+// error if the command line is not valid.
+// If the error concerns a specific option, then the hook should return a value of type *OptionError, so that callers
+// know which option failed.
+//
+// Example:
+//
+//     cli.Parse(os.Args[1:], spec, cli.Validator(func(inSpec cli.Spec) error {
+//         if end <= start { return &cli.OptionError{Name: "end", Value: fmt.Sprint(end), Err: errors.New("must be after --start")} }
+//         return nil
+//     }))
+
+type Validator func(inSpec Spec) error
+
+func (v Validator) check(inLine *parsedLine) error {
+	return v(inLine.spec)
+}
@@ -1,44 +1,254 @@
-package cli
-
-type errorMessageType string
-
-const (
-	// ----------------------------------------------------------------
-	// cli.go
-	// ----------------------------------------------------------------
-
-	errorDuplicatedFlagOption = `Duplicated use of flag option "%s".`
-	errorDuplicatedNonFlagOption = `Duplicated use of non flag option "%s".`
-	errorUnexpectedShortNamedOption = `Unexpected option which short name is "%s".`
-	errorUnexpectedLongNamedOption = `Unexpected option which long name is "%s".`
-	errorNonFlagOptionWithinCompound = `The option "%s" requires a value. It cannot appear within a compound.`
-	errorInvalidOptionSpecifier = `Invalid option specifier "%s" at position %d.`
-	errorUnexpectedEndOfOptionsListSpec = `Unexpected end of list of options mark ("--") encountered. A value was expected.`
-	errorValueExpectedOptionEncountered = `Unexpected option specifier found. A value was expected.`
-
-	// ----------------------------------------------------------------
-	// option.go
-	// ----------------------------------------------------------------
-
-	errorInvalidOptionSpecificationNoName = `Invalid option specifer: no name (whether short or long) is specified for this option.`
-	errorInvalidOptionSpecificationUnexpectedHolderType = `Invalid option definition: wrong type detected for the value holder.`
-	errorShortNameTooLong = `Invalid short name "%s". A short name should contain only one letter.`
-	errorShortNameUnexpectedCharacter = `Invalid short name for option "%s".`
-	errorLongNameUnexpectedCharacter = `Invalid long name for option "%s".`
-	errorUnexpectedType = `Unepected type`
-	errorInvalidValueBoolExpected = `Invalid value for option. Expected a value of type bool.`
-	errorInvalidValueStringExpected = `Invalid value for option. Expected a value of type string.`
-
-	// ----------------------------------------------------------------
-	// spec.go
-	// ----------------------------------------------------------------
-
-	// TODO: unit test for errorInvalidCmdLineSpecInvalidOptionDefinition
-
-	errorInvalidCmdLineSpecInvalidOptionDefinition = `Invalid command line specification. Invalid option found at position %d: "%s".`
-	errorInvalidCmdLineSpecDuplicatedShortNamedOption = `Invalid command line specification. Duplicated name for short option at position %d: "%s".`
-	errorInvalidCmdLineSpecDuplicatedLongNamedOption = `Invalid command line specification. Duplicated name for long option at positino %d: "%s".`
-	errorInvalidCmdLineSpecReuseOfValueHolder = `Invalid command line specification. Duplicated value holder for the option at position %d.`
-)
-
-
+package cli
+
+type errorMessageType string
+
+const (
+	// ----------------------------------------------------------------
+	// cli.go
+	// ----------------------------------------------------------------
+
+	errorDuplicatedFlagOption = `Duplicated use of flag option "%s".`
+	errorDuplicatedNonFlagOption = `Duplicated use of non flag option "%s".`
+	errorUnexpectedShortNamedOption = `Unexpected option which short name is "%s".`
+	errorUnexpectedLongNamedOption = `Unexpected option which long name is "%s".`
+	errorNonFlagOptionWithinCompound = `The option "%s" requires a value. It cannot appear within a compound.`
+	errorInvalidOptionSpecifier = `Invalid option specifier "%s" at position %d.`
+	errorUnexpectedEndOfOptionsListSpec = `Unexpected end of list of options mark ("--") encountered. A value was expected.`
+	errorValueExpectedOptionEncountered = `Unexpected option specifier found. A value was expected.`
+	errorAttachedValueWithinCompound = `Invalid option specifier "%s" at position %d. A value cannot be attached to a compound.`
+	errorMissingValue = `Unexpected end of command line. A value was expected for option "%s".`
+
+	// ----------------------------------------------------------------
+	// option.go
+	// ----------------------------------------------------------------
+
+	errorInvalidOptionSpecificationNoName = `Invalid option specifer: no name (whether short or long) is specified for this option.`
+	errorInvalidOptionSpecificationUnexpectedHolderType = `Invalid option definition: wrong type detected for the value holder.`
+	errorShortNameTooLong = `Invalid short name "%s". A short name should contain only one letter.`
+	errorShortNameUnexpectedCharacter = `Invalid short name for option "%s".`
+	errorLongNameUnexpectedCharacter = `Invalid long name for option "%s".`
+	errorUnexpectedType = `Unepected type`
+	errorInvalidValueBoolExpected = `Invalid value for option. Expected a value of type bool.`
+	errorInvalidValueStringExpected = `Invalid value for option. Expected a value of type string.`
+	errorInvalidKind = `Invalid option definition: unexpected kind of values (%d).`
+
+	// ----------------------------------------------------------------
+	// choice.go
+	// ----------------------------------------------------------------
+
+	errorChoicesUnexpectedHolderType = `Invalid option definition: allowed values can only be specified for strings and integers.`
+	errorInvalidIntegerChoice = `Invalid option definition: the allowed value "%s" is not an integer.`
+	errorInvalidValueNotAllowed = `Invalid value "%s" for option "%s". Allowed values are: %s.`
+
+	// ----------------------------------------------------------------
+	// number.go
+	// ----------------------------------------------------------------
+
+	errorNumericSyntaxUnexpectedHolderType = `Invalid option definition: a numeric syntax can only be specified for integers.`
+	errorInvalidNumericSyntax = `Invalid option definition: unexpected numeric syntax (%d).`
+	errorRangeUnexpectedHolderType = `Invalid option definition: a range of values can only be specified for numeric options.`
+	errorInvalidRangeBound = `Invalid option definition: the bound "%v" is not a numeric value.`
+	errorInvalidRangeEmpty = `Invalid option definition: the range %s is empty.`
+	errorInvalidValueOutOfRange = `Invalid value "%s" for option "%s". The value must be in the range %s.`
+
+	// ----------------------------------------------------------------
+	// size.go
+	// ----------------------------------------------------------------
+
+	errorSizeUnexpectedHolderType = `Invalid option definition: sizes can only be stored within integers.`
+	errorInvalidValueSizeExpected = `Invalid value "%s" for option "%s". Expected a size: a number followed by an optional unit (ex: 512, 4k, 512MiB or 1.5GB).`
+	errorInvalidValueSizeTooLarge = `Invalid value "%s" for option "%s". The size must not exceed %s.`
+
+	// ----------------------------------------------------------------
+	// ranges.go
+	// ----------------------------------------------------------------
+
+	errorRangesUnexpectedHolderType = `Invalid option definition: ranges can only be stored within lists of integers.`
+	errorInvalidValueRangesExpected = `Invalid value "%s" for option "%s". Expected a list of integers and ranges (ex: 0-3,8,10-11).`
+	errorInvalidValueReversedRange = `Invalid value "%s" for option "%s". The lower bound of the range "%s" is greater than its upper bound.`
+	errorInvalidValueRangeOverflow = `Invalid value "%s" for option "%s". The range "%s" does not fit within the value holder (from %s to %s).`
+	errorInvalidValueOverlappingRanges = `Invalid value "%s" for option "%s". The integer %s is given more than once.`
+	errorInvalidValueRangesTooLong = `Invalid value "%s" for option "%s". The list of integers must not contain more than %d elements.`
+
+	// ----------------------------------------------------------------
+	// network.go
+	// ----------------------------------------------------------------
+
+	errorInvalidValueIPExpected = `Invalid value "%s" for option "%s". Expected an IP address (ex: 192.168.0.1 or ::1).`
+	errorInvalidValueCIDRExpected = `Invalid value "%s" for option "%s". Expected a network in CIDR notation (ex: 192.168.0.0/24 or 2001:db8::/32).`
+	errorInvalidValueAddrPortExpected = `Invalid value "%s" for option "%s". Expected an IP address and a port (ex: 192.168.0.1:8080 or [::1]:8080).`
+	errorInvalidValueURLExpected = `Invalid value "%s" for option "%s". Expected an absolute URL (ex: https://example.com/path).`
+
+	// ----------------------------------------------------------------
+	// bool.go
+	// ----------------------------------------------------------------
+
+	errorStrictBoolUnexpectedHolderType = `Invalid option definition: the strict boolean syntax can only be specified for flags.`
+	errorInvalidValueBoolWordExpected = `Invalid value "%s" for option "%s". Expected %s.`
+
+	// ----------------------------------------------------------------
+	// json.go
+	// ----------------------------------------------------------------
+
+	errorJSONStrictUnexpectedKind = `Invalid option definition: the strict JSON decoding can only be specified for JSON values.`
+	errorInvalidValueJSON = `Invalid value "%s" for option "%s". Invalid JSON document at offset %d: %s.`
+
+	// ----------------------------------------------------------------
+	// big.go
+	// ----------------------------------------------------------------
+
+	errorPrecisionUnexpectedHolderType = `Invalid option definition: a precision can only be specified for arbitrary precision floats.`
+	errorBaseUnexpectedHolderType = `Invalid option definition: a base can only be specified for arbitrary precision integers or floats.`
+	errorInvalidBase = `Invalid option definition: invalid base (%d).`
+	errorInvalidValueBigIntExpected = `Invalid value "%s" for option "%s". Expected an integer (base %d).`
+	errorInvalidValueBigFloatExpected = `Invalid value "%s" for option "%s". Expected a number (base %d).`
+	errorInvalidValueBigRatExpected = `Invalid value "%s" for option "%s". Expected a rational number (ex: 3/4 or 1.25).`
+
+	// ----------------------------------------------------------------
+	// regexp.go
+	// ----------------------------------------------------------------
+
+	errorGlobUnexpectedHolderType = `Invalid option definition: glob patterns can only be stored within strings or lists of strings.`
+	errorInvalidValueRegexp = `Invalid value "%s" for option "%s". Invalid regular expression (%s).`
+	errorInvalidValueGlob = `Invalid value "%s" for option "%s". Expected a glob pattern (ex: *.log).`
+
+	// ----------------------------------------------------------------
+	// rest.go
+	// ----------------------------------------------------------------
+
+	errorRestUnexpectedHolderType = `Invalid option definition: rest-of-line options can only store their values within lists of strings.`
+	errorRestUnexpectedSource = `Invalid option definition: the values of rest-of-line options cannot be read from files.`
+	errorTerminatorUnexpectedKind = `Invalid option definition: a terminator can only be specified for rest-of-line options.`
+	errorAttachedValueRestOption = `Invalid option specifier "%s". A value cannot be attached to a rest-of-line option.`
+	errorDuplicatedRestOption = `Duplicated use of rest-of-line option "%s".`
+	errorMissingRestTerminator = `The option %s must be terminated by "%s".`
+
+	// ----------------------------------------------------------------
+	// required.go
+	// ----------------------------------------------------------------
+
+	errorMissingRequiredOption = `Missing required option: %s.`
+	errorMissingRequiredOptions = `Missing required options: %s.`
+
+	// ----------------------------------------------------------------
+	// group.go
+	// ----------------------------------------------------------------
+
+	errorGroupTooSmall = `Invalid option group: a group must contain at least two options.`
+	errorGroupUnexpectedOption = `Invalid option group: unexpected option "%s".`
+	errorInvalidGroupKind = `Invalid option group: unexpected kind of group (%d).`
+	errorGroupExclusive = `The options %s are mutually exclusive.`
+	errorGroupAtLeastOne = `At least one of the options %s is required.`
+	errorGroupExactlyOne = `Exactly one of the options %s is required.`
+	errorGroupAllOrNone = `The options %s must be used together (missing %s).`
+
+	// ----------------------------------------------------------------
+	// implication.go
+	// ----------------------------------------------------------------
+
+	errorImplicationUnexpectedOption = `Invalid implication rule: unexpected option "%s".`
+	errorImplicationNoRequirement = `Invalid implication rule: no required option.`
+	errorImplicationViolated = `The option %s requires the option %s.`
+	errorImplicationsViolated = `The option %s requires the options %s (missing %s).`
+
+	// ----------------------------------------------------------------
+	// occurrence.go
+	// ----------------------------------------------------------------
+
+	errorInvalidOccurrences = `Invalid option definition: invalid numbers of occurrences (minimum: %d, maximum: %d).`
+	errorOccurrencesUnexpectedHolderType = `Invalid option definition: an option that can appear only once cannot be required to appear more than once.`
+	errorTooFewOccurrences = `The option %s must appear at least %d time(s) (found %d).`
+	errorTooManyOccurrences = `The option %s must appear at most %d time(s) (found %d).`
+
+	// ----------------------------------------------------------------
+	// arguments.go
+	// ----------------------------------------------------------------
+
+	errorArgumentNoName = `Invalid argument definition: the argument at position %d has no name.`
+	errorInvalidArgumentDefinition = `Invalid argument definition for argument <%s>: %s`
+	errorArgumentUnexpectedHolderType = `Invalid argument definition for argument <%s>: unexpected value holder type (only a variadic argument can store a list of values).`
+	errorArgumentVariadicNotLast = `Invalid argument definition for argument <%s>: only the last argument can be variadic.`
+	errorArgumentRequiredAfterOptional = `Invalid argument definition for argument <%s>: a required argument cannot follow an optional one.`
+	errorArgumentCountUnexpected = `Invalid argument definition for argument <%s>: counts can only be specified for variadic arguments.`
+	errorInvalidArgumentCount = `Invalid argument definition for argument <%s>: invalid counts (minimum: %d, maximum: %d).`
+	errorMissingArgument = `Missing argument <%s>.`
+	errorTooFewArgumentValues = `The argument <%s> requires at least %d value(s) (found %d).`
+	errorTooManyArgumentValues = `The argument <%s> accepts at most %d value(s) (found %d).`
+	errorUnexpectedArgument = `Unexpected argument "%s".`
+	errorInvalidArgumentValue = `Invalid value "%s" for argument <%s>: %s`
+
+	// ----------------------------------------------------------------
+	// default.go
+	// ----------------------------------------------------------------
+
+	errorInvalidDefault = `Invalid option definition: invalid default value. %s`
+	errorDefaultUnexpectedList = `Invalid option definition: the default value of an option that can appear only once cannot be a list.`
+	errorDefaultUnexpectedType = `Invalid option definition: the default value (%#v) is not compatible with the value holder.`
+
+	// ----------------------------------------------------------------
+	// bytes.go
+	// ----------------------------------------------------------------
+
+	errorInvalidEncoding = `Invalid option definition: unknown encoding (%d).`
+	errorEncodingUnexpectedHolderType = `Invalid option definition: an encoding can only be specified for byte slices.`
+	errorByteLengthWithoutEncoding = `Invalid option definition: a length in bytes can only be specified for options that have an encoding.`
+	errorInvalidByteLength = `Invalid option definition: invalid length in bytes (%d).`
+	errorInvalidValueEncodingExpected = `Invalid value "%s" for option "%s". Expected %s.`
+	errorInvalidValueByteLength = `Invalid value "%s" for option "%s". The decoded value must contain exactly %d bytes (got %d).`
+
+	// ----------------------------------------------------------------
+	// path.go
+	// ----------------------------------------------------------------
+
+	errorPathUnexpectedHolderType = `Invalid option definition: paths can only be stored within strings, lists of strings or files.`
+	errorPathFlagsUnexpectedKind = `Invalid option definition: path flags can only be specified for paths.`
+	errorInvalidPathFlags = `Invalid option definition: a path cannot be both a file and a directory.`
+	errorInvalidValuePathExpansion = `Invalid value "%s" for option "%s". The path cannot be expanded: %s.`
+	errorInvalidValuePathNotFound = `Invalid value "%s" for option "%s". The path does not exist.`
+	errorInvalidValuePathNotAFile = `Invalid value "%s" for option "%s". The path is not a regular file.`
+	errorInvalidValuePathNotADirectory = `Invalid value "%s" for option "%s". The path is not a directory.`
+	errorInvalidValuePathNoParent = `Invalid value "%s" for option "%s". The parent directory does not exist.`
+	errorInvalidValuePathNotWritable = `Invalid value "%s" for option "%s". The path is not writable.`
+	errorInvalidValueFileOpen = `Invalid value "%s" for option "%s". The file cannot be opened: %s.`
+
+	// ----------------------------------------------------------------
+	// source.go
+	// ----------------------------------------------------------------
+
+	errorFileSourceUnexpectedHolderType = `Invalid option definition: values can only be read from files for options that take values.`
+	errorFileOptionWithoutLongName = `Invalid option definition: a paired "-file" option requires a long name.`
+	errorInvalidValueFileRead = `Cannot read the value of option "%s" from "%s": %s.`
+	errorInvalidValueFileTooLarge = `Cannot read the value of option "%s" from "%s": the content exceeds %d bytes.`
+
+	// ----------------------------------------------------------------
+	// pattern.go
+	// ----------------------------------------------------------------
+
+	errorPatternUnexpectedHolderType = `Invalid option definition: a pattern or a length can only be specified for strings.`
+	errorInvalidPattern = `Invalid option definition: invalid regular expression "%s" (%s).`
+	errorInvalidLengthRange = `Invalid option definition: invalid lengths (minimum: %d, maximum: %d).`
+	errorInvalidValuePattern = `Invalid value "%s" for option "%s". The value must match the regular expression "%s".`
+	errorInvalidValueDescription = `Invalid value "%s" for option "%s". Expected %s.`
+	errorInvalidValueTooShort = `Invalid value "%s" for option "%s". The value must contain at least %d characters.`
+	errorInvalidValueTooLong = `Invalid value "%s" for option "%s". The value must contain at most %d characters.`
+
+	// ----------------------------------------------------------------
+	// validator.go
+	// ----------------------------------------------------------------
+
+	errorInvalidValueRejected = `Invalid value "%s" for option "%s": %s`
+
+	// ----------------------------------------------------------------
+	// spec.go
+	// ----------------------------------------------------------------
+
+	// TODO: unit test for errorInvalidCmdLineSpecInvalidOptionDefinition
+
+	errorInvalidCmdLineSpecInvalidOptionDefinition = `Invalid command line specification. Invalid option found at position %d: "%s".`
+	errorInvalidCmdLineSpecDuplicatedShortNamedOption = `Invalid command line specification. Duplicated name for short option at position %d: "%s".`
+	errorInvalidCmdLineSpecDuplicatedLongNamedOption = `Invalid command line specification. Duplicated name for long option at positino %d: "%s".`
+	errorInvalidCmdLineSpecReuseOfValueHolder = `Invalid command line specification. Duplicated value holder for the option at position %d.`
+	errorInvalidCmdLineSpecDuplicatedFileOption = `Invalid command line specification. The paired option of the option at position %d conflicts with a long option: "%s".`
+)
+
+
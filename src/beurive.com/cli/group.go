@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// This type represents the constraint that applies to the options of a group.
+// - GroupExclusive: at most one option of the group may appear within the command line (ex: "--json" and "--yaml").
+// - GroupAtLeastOne: at least one option of the group must appear within the command line.
+// - GroupExactlyOne: one, and only one, option of the group must appear within the command line (ex: "--file" or
+//   "--url").
+// - GroupAllOrNone: either all the options of the group appear within the command line, or none of them (ex: "--user"
+//   and "--password").
+
+type groupKind int
+
+const (
+	GroupExclusive groupKind = iota
+	GroupAtLeastOne
+	GroupExactlyOne
+	GroupAllOrNone
+)
+
+// The type Group represents a group of options, which use is constrained. Groups are directives: they are given to the
+// function Parse, after the specification, and they are checked once the command line has been parsed. Groups are
+// also reflected within the usage (see the function Usage).
+// * The attribute "Kind" defines the constraint that applies to the options of the group (ex: GroupExclusive).
+// * The attribute "Options" contains the names of the options of the group. A name may be a long name or a short name
+//   (see Spec.Lookup).
+//
+// Example:
+//
+//     cli.Parse(os.Args[1:], spec, cli.Group{Kind: cli.GroupExclusive, Options: []string{"json", "yaml"}})
+
+type Group struct {
+	Kind groupKind   // The constraint that applies to the options of the group.
+	Options []string // The names of the options of the group.
+}
+
+// Return the options of a group.
+// If a name does not identify an option of the specification, then the function returns an error.
+
+func (g Group) getOptions(inSpec Spec) ([]*Option, error) {
+	if len(g.Options) < 2 {
+		return nil, errors.New(errorGroupTooSmall)
+	}
+	options := make([]*Option, 0, len(g.Options))
+	for _, name := range g.Options {
+		o := inSpec.Lookup(name)
+		if nil == o {
+			return nil, errors.New(fmt.Sprintf(errorGroupUnexpectedOption, name))
+		}
+		options = append(options, o)
+	}
+	return options, nil
+}
+
+// Return the list of the specifiers of some options (ex: "--json, --yaml").
+
+func joinSpecifiers(inOptions []*Option) string {
+	specifiers := make([]string, len(inOptions))
+	for i, o := range inOptions {
+		specifiers[i] = o.getSpecifier()
+	}
+	return strings.Join(specifiers, `, `)
+}
+
+// Check that the options of a group that appear within the command line satisfy the group's constraint.
+// Please note that a flag given the value false (ex: "--json=false") does not count as present.
+
+func (g Group) check(inLine *parsedLine) error {
+	options, err := g.getOptions(inLine.spec)
+	if nil != err { return err }
+
+	set := make([]*Option, 0)
+	unset := make([]*Option, 0)
+	for _, o := range options {
+		if o.isEnabled() {
+			set = append(set, o)
+		} else {
+			unset = append(unset, o)
+		}
+	}
+
+	switch g.Kind {
+		case GroupExclusive:
+			if len(set) > 1 {
+				return errors.New(fmt.Sprintf(errorGroupExclusive, joinSpecifiers(set)))
+			}
+		case GroupAtLeastOne:
+			if 0 == len(set) {
+				return errors.New(fmt.Sprintf(errorGroupAtLeastOne, joinSpecifiers(options)))
+			}
+		case GroupExactlyOne:
+			if 1 != len(set) {
+				return errors.New(fmt.Sprintf(errorGroupExactlyOne, joinSpecifiers(options)))
+			}
+		case GroupAllOrNone:
+			if 0 != len(set) && 0 != len(unset) {
+				return errors.New(fmt.Sprintf(errorGroupAllOrNone, joinSpecifiers(options), joinSpecifiers(unset)))
+			}
+		default:
+			return errors.New(fmt.Sprintf(errorInvalidGroupKind, g.Kind))
+	}
+	return nil
+}
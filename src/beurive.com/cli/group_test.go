@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"testing"
+)
+
+// -----------------------------------------------------------------
+// Test the groups of options.
+// -----------------------------------------------------------------
+
+func TestGroupOk(t *testing.T)  {
+	var cloJSON bool
+	var cloYAML bool
+	var cloFile string
+	var cloURL string
+	var cloUser string
+	var cloPassword string
+
+	spec := Spec{
+		Option{Long: "json",              Holder: &cloJSON},
+		Option{Long: "yaml",              Holder: &cloYAML},
+		Option{Short: "f", Long: "file",  Holder: &cloFile},
+		Option{Short: "u", Long: "url",   Holder: &cloURL},
+		Option{Long: "user",              Holder: &cloUser},
+		Option{Long: "password",          Holder: &cloPassword},
+	}
+	groups := []Directive{
+		Group{Kind: GroupExclusive,  Options: []string{"json", "yaml"}},
+		Group{Kind: GroupExactlyOne, Options: []string{"f", "url"}},
+		Group{Kind: GroupAllOrNone,  Options: []string{"user", "password"}},
+	}
+
+	for i, input := range [][]string{
+		{"-f", "/path/to/file"},
+		{"--json", "--url", "https://example.com"},
+		{"--yaml", "--file", "/path/to/file", "--user", "admin", "--password", "secret"},
+	} {
+		if _, _, err := Parse(input, spec, groups...); nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+		}
+	}
+
+	atLeastOne := Group{Kind: GroupAtLeastOne, Options: []string{"json", "yaml"}}
+	if _, _, err := Parse([]string{"--json", "--yaml"}, spec, atLeastOne); nil != err {
+		t.Errorf(`Unexpected error: %s`, err.Error())
+	}
+
+	// A flag given the value false does not count as present.
+	if _, _, err := Parse([]string{"--json=false", "--yaml", "-f", "/path/to/file"}, spec, groups...); nil != err {
+		t.Errorf(`Unexpected error: %s`, err.Error())
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_GroupKo(t *testing.T)  {
+	var cloJSON bool
+	var cloYAML bool
+	var cloFile string
+	var cloURL string
+
+	spec := Spec{
+		Option{Long: "json",              Holder: &cloJSON},
+		Option{Long: "yaml",              Holder: &cloYAML},
+		Option{Short: "f", Long: "file",  Holder: &cloFile},
+		Option{Short: "u",                Holder: &cloURL},
+	}
+
+	type testSet struct {
+		group Group
+		input []string
+		expected string
+	}
+
+	for i, set := range []testSet{
+		{group: Group{Kind: GroupExclusive, Options: []string{"json", "yaml"}},
+			input: []string{"--yaml", "--json"},
+			expected: `The options --json, --yaml are mutually exclusive.`},
+		{group: Group{Kind: GroupAtLeastOne, Options: []string{"json", "yaml"}},
+			input: []string{},
+			expected: `At least one of the options --json, --yaml is required.`},
+		{group: Group{Kind: GroupExactlyOne, Options: []string{"file", "u"}},
+			input: []string{},
+			expected: `Exactly one of the options --file, -u is required.`},
+		{group: Group{Kind: GroupExactlyOne, Options: []string{"file", "u"}},
+			input: []string{"-f", "/path", "-u", "https://example.com"},
+			expected: `Exactly one of the options --file, -u is required.`},
+		{group: Group{Kind: GroupAllOrNone, Options: []string{"json", "yaml", "file"}},
+			input: []string{"--yaml"},
+			expected: `The options --json, --yaml, --file must be used together (missing --json, --file).`},
+		{group: Group{Kind: GroupAtLeastOne, Options: []string{"json", "yaml"}},
+			input: []string{"--json=false"},
+			expected: `At least one of the options --json, --yaml is required.`},
+		{group: Group{Kind: GroupExclusive, Options: []string{"json", "xml"}},
+			input: []string{},
+			expected: `Invalid option group: unexpected option "xml".`},
+		{group: Group{Kind: GroupExclusive, Options: []string{"json"}},
+			input: []string{},
+			expected: errorGroupTooSmall},
+		{group: Group{Kind: groupKind(99), Options: []string{"json", "yaml"}},
+			input: []string{},
+			expected: `Invalid option group: unexpected kind of group (99).`},
+	} {
+		_, _, err := Parse(set.input, spec, set.group)
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if set.expected != err.Error() {
+			t.Errorf(`Test #%d failed! Got "%s", expected "%s".`, i, err.Error(), set.expected)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The type Implication represents a rule that makes some options depend on another one. Implications are directives:
+// they are given to the function Parse, after the specification, and they are checked once the command line has been
+// parsed.
+// * The attribute "Option" contains the name of the option that triggers the rule (long name or short name).
+// * The attribute "Value" contains the value that triggers the rule, as it appears within the command line (ex:
+//   "replica"). An empty value means that the rule is triggered whatever the value of the option. The rule is
+//   triggered if any value given to the option matches (for options that can appear more than once).
+// * The attribute "Requires" contains the names of the options that must appear within the command line if the rule
+//   is triggered.
+//
+// Please note that default values never trigger rules, and never satisfy them. Likewise, a flag given the value false
+// (ex: "--tls=false") neither triggers a rule without value, nor satisfies a rule.
+//
+// Examples:
+//
+//     cli.Implication{Option: "tls-cert", Requires: []string{"tls"}}
+//     cli.Implication{Option: "mode", Value: "replica", Requires: []string{"primary"}}
+
+type Implication struct {
+	Option string     // The name of the option that triggers the rule.
+	Value string      // The value that triggers the rule (empty means any value).
+	Requires []string // The names of the options required if the rule is triggered.
+}
+
+// Test whether an option has been given a specific value within the command line.
+// If the option's values are case insensitive, then the comparison is case insensitive.
+
+func (o *Option) hasValue(inValue string) bool {
+	for _, v := range o.values {
+		if inValue == v || (o.IgnoreCase && strings.EqualFold(inValue, v)) { return true }
+	}
+	return false
+}
+
+// Check that the options required by a rule appear within the command line, if the rule is triggered.
+
+func (r Implication) check(inLine *parsedLine) error {
+	o := inLine.spec.Lookup(r.Option)
+	if nil == o {
+		return errors.New(fmt.Sprintf(errorImplicationUnexpectedOption, r.Option))
+	}
+	if 0 == len(r.Requires) {
+		return errors.New(errorImplicationNoRequirement)
+	}
+	required := make([]*Option, 0, len(r.Requires))
+	for _, name := range r.Requires {
+		p := inLine.spec.Lookup(name)
+		if nil == p {
+			return errors.New(fmt.Sprintf(errorImplicationUnexpectedOption, name))
+		}
+		required = append(required, p)
+	}
+
+	// A rule without value is triggered by a flag only if the flag is true.
+	if "" == r.Value && ! o.isEnabled() { return nil }
+	if "" != r.Value && (! o.IsSet() || ! o.hasValue(r.Value)) { return nil }
+
+	missing := make([]*Option, 0)
+	for _, p := range required {
+		if ! p.isEnabled() { missing = append(missing, p) }
+	}
+	if 0 == len(missing) { return nil }
+
+	trigger := o.getSpecifier()
+	if "" != r.Value { trigger = fmt.Sprintf(`%s=%s`, trigger, o.displayValue(r.Value)) }
+	if 1 == len(required) {
+		return errors.New(fmt.Sprintf(errorImplicationViolated, trigger, joinSpecifiers(required)))
+	}
+	return errors.New(fmt.Sprintf(errorImplicationsViolated, trigger, joinSpecifiers(required), joinSpecifiers(missing)))
+}
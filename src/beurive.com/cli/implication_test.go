@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"testing"
+)
+
+// -----------------------------------------------------------------
+// Test the implication rules.
+// -----------------------------------------------------------------
+
+func TestImplicationOk(t *testing.T)  {
+	var cloTLS bool
+	var cloCert string
+	var cloMode string
+	var cloPrimary string
+
+	spec := Spec{
+		Option{Long: "tls",      Holder: &cloTLS},
+		Option{Long: "tls-cert", Holder: &cloCert},
+		Option{Short: "m", Long: "mode", Holder: &cloMode, Choices: []string{"primary", "replica"}, IgnoreCase: true, Default: "replica"},
+		Option{Long: "primary",  Holder: &cloPrimary},
+	}
+	rules := []Directive{
+		Implication{Option: "tls-cert", Requires: []string{"tls"}},
+		Implication{Option: "m", Value: "replica", Requires: []string{"primary"}},
+		Implication{Option: "tls", Requires: []string{"tls-cert"}},
+	}
+
+	for i, input := range [][]string{
+		{},
+		{"--tls=false"},
+		{"--tls", "--tls-cert", "/path/to/cert"},
+		{"--mode", "primary"},
+		{"--mode", "REPLICA", "--primary", "db1"},
+	} {
+		if _, _, err := Parse(input, spec, rules...); nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_ImplicationKo(t *testing.T)  {
+	var cloTLS bool
+	var cloCert string
+	var cloKey string
+	var cloMode string
+	var cloPrimary string
+	var cloCache bool
+
+	spec := Spec{
+		Option{Long: "tls",      Holder: &cloTLS},
+		Option{Long: "tls-cert", Holder: &cloCert},
+		Option{Long: "tls-key",  Holder: &cloKey},
+		Option{Short: "m", Long: "mode", Holder: &cloMode},
+		Option{Long: "primary",  Holder: &cloPrimary},
+		Option{Long: "cache",    Holder: &cloCache},
+	}
+
+	type testSet struct {
+		rule Implication
+		input []string
+		expected string
+	}
+
+	for i, set := range []testSet{
+		{rule: Implication{Option: "tls-cert", Requires: []string{"tls"}},
+			input: []string{"--tls-cert", "/path/to/cert"},
+			expected: `The option --tls-cert requires the option --tls.`},
+		{rule: Implication{Option: "tls-cert", Requires: []string{"tls"}},
+			input: []string{"--tls-cert", "/path/to/cert", "--tls=false"},
+			expected: `The option --tls-cert requires the option --tls.`},
+		{rule: Implication{Option: "tls", Requires: []string{"tls-cert", "tls-key"}},
+			input: []string{"--tls", "--tls-key", "/path/to/key"},
+			expected: `The option --tls requires the options --tls-cert, --tls-key (missing --tls-cert).`},
+		{rule: Implication{Option: "m", Value: "replica", Requires: []string{"primary"}},
+			input: []string{"-m", "replica"},
+			expected: `The option --mode=replica requires the option --primary.`},
+		{rule: Implication{Option: "cache", Value: "false", Requires: []string{"primary"}},
+			input: []string{"--cache=false"},
+			expected: `The option --cache=false requires the option --primary.`},
+		{rule: Implication{Option: "tls-ca", Requires: []string{"tls"}},
+			input: []string{},
+			expected: `Invalid implication rule: unexpected option "tls-ca".`},
+		{rule: Implication{Option: "tls", Requires: []string{"tls-ca"}},
+			input: []string{},
+			expected: `Invalid implication rule: unexpected option "tls-ca".`},
+		{rule: Implication{Option: "tls"},
+			input: []string{},
+			expected: errorImplicationNoRequirement},
+	} {
+		_, _, err := Parse(set.input, spec, set.rule)
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if set.expected != err.Error() {
+			t.Errorf(`Test #%d failed! Got "%s", expected "%s".`, i, err.Error(), set.expected)
+		}
+	}
+}
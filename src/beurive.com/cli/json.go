@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Check that the strict decoding is only requested for options which kind is KindJSON.
+// Please note that any pointer can be used as value holder for JSON values (ex: a pointer to a structure or to a map).
+
+func (o *Option) initJSON() error {
+	if KindJSON != o.Kind && o.JSONStrict {
+		return errors.New(errorJSONStrictUnexpectedKind)
+	}
+	return nil
+}
+
+// Return the offset, within a JSON document, of the problem described by an error returned by the JSON decoder.
+// The decoder does not give the offset of unknown fields. In this case, the offset is the position of the field's name
+// within the document. If the offset cannot be determined, then the function returns the current offset of the decoder.
+
+func getJSONOffset(inError error, inDocument string, inDecoder *json.Decoder) int64 {
+	var rx *regexp.Regexp = regexp.MustCompile(`^json: unknown field ("(?:[^"\\]|\\.)*")$`)
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	if errors.As(inError, &syntaxError) { return syntaxError.Offset }
+	if errors.As(inError, &typeError) { return typeError.Offset }
+	if m := rx.FindStringSubmatch(inError.Error()); nil != m {
+		field := regexp.MustCompile(regexp.QuoteMeta(m[1]) + `\s*:`)
+		if loc := field.FindStringIndex(inDocument); nil != loc { return int64(loc[0]) }
+	}
+	return inDecoder.InputOffset()
+}
+
+// Decode a JSON document and store the result within the option's value holder.
+// If the option's decoding is strict, then the document must not contain fields that do not exist within the value
+// holder. The document must contain one, and only one, JSON value.
+// Please note that the value holder is modified only if the document is valid.
+
+func (o *Option) decodeJSON(inValue string) error {
+	holder := reflect.ValueOf(o.Holder).Elem()
+	target := reflect.New(holder.Type())
+
+	decoder := json.NewDecoder(strings.NewReader(inValue))
+	if o.JSONStrict { decoder.DisallowUnknownFields() }
+
+	err := decoder.Decode(target.Interface())
+	if nil == err {
+		// Make sure that nothing follows the JSON value.
+		rest := strings.TrimLeft(inValue[decoder.InputOffset():], " \t\r\n")
+		if "" != rest {
+			return errors.New(fmt.Sprintf(errorInvalidValueJSON, o.displayValue(inValue), o.getName(), len(inValue) - len(rest), `unexpected data after the JSON value`))
+		}
+		holder.Set(target.Elem())
+		return nil
+	}
+
+	if io.EOF == err || io.ErrUnexpectedEOF == err {
+		return errors.New(fmt.Sprintf(errorInvalidValueJSON, o.displayValue(inValue), o.getName(), len(inValue), `unexpected end of JSON input`))
+	}
+	return errors.New(fmt.Sprintf(errorInvalidValueJSON, o.displayValue(inValue), o.getName(), getJSONOffset(err, inValue, decoder), strings.TrimPrefix(o.displayError(err), `json: `)))
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+)
+
+// -----------------------------------------------------------------
+// Test the decoding of JSON values.
+// -----------------------------------------------------------------
+
+type jsonFilter struct {
+	Status []string `json:"status"`
+	Limit int       `json:"limit"`
+}
+
+func TestJSONOk(t *testing.T)  {
+	var cloFilter jsonFilter
+	var cloLabels map[string]string
+	var cloIDs []int
+	var cloOptional *jsonFilter
+
+	spec := Spec{
+		Option{Short: "f", Long: "filter",   Holder: &cloFilter,   Kind: KindJSON, JSONStrict: true},
+		Option{Short: "l", Long: "labels",   Holder: &cloLabels,   Kind: KindJSON},
+		Option{Short: "i", Long: "ids",      Holder: &cloIDs,      Kind: KindJSON},
+		Option{Short: "o", Long: "optional", Holder: &cloOptional, Kind: KindJSON},
+	}
+
+	input := []string{"--filter", `{"status":["open"],"limit":10}`, "-l", `{"env":"prod"}`, "--ids", ` [1, 2, 3] `}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if 1 != len(cloFilter.Status) || "open" != cloFilter.Status[0] || 10 != cloFilter.Limit {
+		t.Errorf(`Unexpected filter: %v.`, cloFilter)
+	}
+	if "prod" != cloLabels["env"] {
+		t.Errorf(`Unexpected labels: %v.`, cloLabels)
+	}
+	if 3 != len(cloIDs) || 3 != cloIDs[2] {
+		t.Errorf(`Unexpected IDs: %v.`, cloIDs)
+	}
+	if nil != cloOptional {
+		t.Errorf(`Unexpected optional filter: %v.`, *cloOptional)
+	}
+
+	// Unknown fields are ignored unless the decoding is strict.
+	spec = Spec{ Option{Long: "filter", Holder: &cloFilter, Kind: KindJSON} }
+	if _, _, err := Parse([]string{"--filter", `{"limit":5,"sort":"asc"}`}, spec); nil != err || 5 != cloFilter.Limit {
+		t.Errorf(`Unexpected result: %v, %v.`, cloFilter, err)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_JSONKo(t *testing.T)  {
+	type testSet struct {
+		option Option
+		input []string
+		expected string
+	}
+
+	var cloFilter jsonFilter
+	var cloName string
+
+	tests := []testSet{
+		{option: Option{Long: "name", Holder: &cloName, JSONStrict: true},
+			expected: errorJSONStrictUnexpectedKind},
+		{option: Option{Long: "filter", Holder: cloFilter, Kind: KindJSON},
+			expected: errorInvalidOptionSpecificationUnexpectedHolderType},
+		{option: Option{Long: "filter", Holder: &cloFilter, Kind: KindJSON},
+			input: []string{"--filter", `{"limit":10,}`},
+			expected: `Invalid value "{"limit":10,}" for option "filter". Invalid JSON document at offset 13: invalid character '}' looking for beginning of object key string.`},
+		{option: Option{Long: "filter", Holder: &cloFilter, Kind: KindJSON},
+			input: []string{"--filter", `{"limit":"ten"}`},
+			expected: `Invalid value "{"limit":"ten"}" for option "filter". Invalid JSON document at offset 14: cannot unmarshal string into Go struct field jsonFilter.limit of type int.`},
+		{option: Option{Long: "filter", Holder: &cloFilter, Kind: KindJSON, JSONStrict: true},
+			input: []string{"--filter", `{"limit":10,"sort":"asc"}`},
+			expected: `Invalid value "{"limit":10,"sort":"asc"}" for option "filter". Invalid JSON document at offset 12: unknown field "sort".`},
+		{option: Option{Long: "filter", Holder: &cloFilter, Kind: KindJSON},
+			input: []string{"--filter", `{"limit":10`},
+			expected: `Invalid value "{"limit":10" for option "filter". Invalid JSON document at offset 11: unexpected end of JSON input.`},
+		{option: Option{Long: "filter", Holder: &cloFilter, Kind: KindJSON},
+			input: []string{"--filter", `{"limit":10} {}`},
+			expected: `Invalid value "{"limit":10} {}" for option "filter". Invalid JSON document at offset 13: unexpected data after the JSON value.`},
+	}
+
+	for i, set := range tests {
+		_, _, err := Parse(set.input, Spec{ set.option })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package cli
+
+// The type Line represents a command line parsed by the function ParseLine.
+// * The attribute "Cli" contains the expanded command line (see the function Parse).
+// * The attribute "Args" contains the arguments that appear before the separator "--" (or all the arguments, if the
+//   separator does not appear within the command line).
+// * The attribute "Trailing" contains the tokens that appear after the separator "--", verbatim. These tokens are
+//   neither interpreted as options nor given to the directives.
+// * The attribute "Separator" contains the position of the separator "--" within the given command line. If the
+//   separator does not appear within the command line, then its value is -1.
+
+type Line struct {
+	Cli []string        // The expanded command line.
+	Args []string       // The arguments that appear before the separator.
+	Trailing []string   // The tokens that appear after the separator.
+	Separator int       // The position of the separator within the command line (-1 if absent).
+}
+
+// Return true if the separator "--" appears within the command line.
+
+func (l *Line) HasSeparator() bool {
+	return l.Separator >= 0
+}
+
+// Parse a command line, relatively to a given specification, like the function Parse. However, the arguments that
+// appear before the first separator "--" and the tokens that appear after it are returned separately. This is useful
+// for programs that pass the trailing tokens to another command (ex: "run -v job -- make -j 4 all").
+// The separator is the first "--" that appears after the options: it may mark the end of the list of options (ex:
+// "run -v -- make all"), or follow some arguments (ex: "run -v job -- make all").
+// Please note that the directives (ex: Arguments) only see the arguments that appear before the separator.
+
+func ParseLine(inCliParams []string, inSpec Spec, inDirectives ...Directive) (*Line, error) {
+	cli, args, separator, err := parse(inCliParams, inSpec)
+	if nil != err { return nil, err }
+
+	line := &Line{Cli: cli, Args: args, Trailing: []string{}, Separator: separator}
+	if line.HasSeparator() {
+		// The separator marks the end of the list of options.
+		line.Args = []string{}
+		line.Trailing = args
+	} else {
+		// The separator may follow some arguments. The arguments are the last strings of the given command line (the
+		// strings that follow the first argument are never interpreted).
+		start := len(inCliParams) - len(args)
+		for i, arg := range args {
+			if isEndOfOptionSpecifier(arg) {
+				line.Separator = start + i
+				line.Args = args[:i]
+				line.Trailing = args[i + 1:]
+				break
+			}
+		}
+	}
+
+	if err := checkLine(&parsedLine{spec: inSpec, args: line.Args}, inDirectives); nil != err {
+		return nil, err
+	}
+	return line, nil
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"testing"
+	"reflect"
+)
+
+// -----------------------------------------------------------------
+// Test the separation of the trailing tokens.
+// -----------------------------------------------------------------
+
+func TestParseLineOk(t *testing.T)  {
+	var cloVerbose bool
+	var cloName string
+
+	spec := Spec{
+		Option{Short: "v", Long: "verbose", Holder: &cloVerbose},
+		Option{Short: "n", Long: "name",    Holder: &cloName},
+	}
+
+	type testSet struct {
+		input []string
+		args []string
+		trailing []string
+		separator int
+	}
+
+	for i, set := range []testSet{
+		{input: []string{}, args: []string{}, trailing: []string{}, separator: -1},
+		{input: []string{"-v", "job", "x"}, args: []string{"job", "x"}, trailing: []string{}, separator: -1},
+		{input: []string{"-v", "--"}, args: []string{}, trailing: []string{}, separator: 1},
+		{input: []string{"-v", "--", "make", "-j", "4"}, args: []string{}, trailing: []string{"make", "-j", "4"}, separator: 1},
+		{input: []string{"-n", "x", "job", "--", "make", "--", "all"}, args: []string{"job"}, trailing: []string{"make", "--", "all"}, separator: 3},
+		{input: []string{"job", "--"}, args: []string{"job"}, trailing: []string{}, separator: 1},
+	} {
+		line, err := ParseLine(set.input, spec)
+		if nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+			continue
+		}
+		if ! reflect.DeepEqual(set.args, line.Args) || ! reflect.DeepEqual(set.trailing, line.Trailing) || set.separator != line.Separator {
+			t.Errorf(`Test #%d failed! Unexpected line: %v, %v, %d`, i, line.Args, line.Trailing, line.Separator)
+		}
+		if line.HasSeparator() != (set.separator >= 0) {
+			t.Errorf(`Test #%d failed! Unexpected presence of the separator.`, i)
+		}
+	}
+}
+
+func TestParseLineArgumentsOk(t *testing.T)  {
+	var argJob string
+
+	// The trailing tokens are not given to the directives.
+	line, err := ParseLine([]string{"job", "--", "make", "all"}, Spec{}, Arguments{{Name: "job", Holder: &argJob}})
+	if nil != err {
+		t.Errorf(`Unexpected error: %s`, err.Error())
+		return
+	}
+	if "job" != argJob || ! reflect.DeepEqual([]string{"make", "all"}, line.Trailing) {
+		t.Errorf(`Unexpected values: "%s", %v`, argJob, line.Trailing)
+	}
+}
+
+func TestParseLineRestOk(t *testing.T)  {
+	var cloExec []string
+	var cloFilter []string
+
+	spec := Spec{
+		Option{Long: "exec",   Holder: &cloExec,   Kind: KindRest},
+		Option{Long: "filter", Holder: &cloFilter, Kind: KindRest, Terminator: ";"},
+	}
+
+	type testSet struct {
+		input []string
+		exec []string
+		args []string
+		trailing []string
+		separator int
+	}
+
+	// A string "--" taken by a rest-of-line option is not a separator.
+	for i, set := range []testSet{
+		{input: []string{"--exec", "echo", "--"}, exec: []string{"echo", "--"}, args: []string{}, trailing: []string{}, separator: -1},
+		{input: []string{"--filter", "--", ";", "--", "x"}, exec: []string{}, args: []string{}, trailing: []string{"x"}, separator: 3},
+		{input: []string{"--filter", "--", ";", "job", "--", "x"}, exec: []string{}, args: []string{"job"}, trailing: []string{"x"}, separator: 4},
+	} {
+		cloExec = []string{}
+		cloFilter = []string{}
+		line, err := ParseLine(set.input, spec)
+		if nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+			continue
+		}
+		if ! reflect.DeepEqual(set.exec, cloExec) {
+			t.Errorf(`Test #%d failed! Unexpected values: %v`, i, cloExec)
+		}
+		if ! reflect.DeepEqual(set.args, line.Args) || ! reflect.DeepEqual(set.trailing, line.Trailing) || set.separator != line.Separator {
+			t.Errorf(`Test #%d failed! Unexpected line: %v, %v, %d`, i, line.Args, line.Trailing, line.Separator)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_ParseLineKo(t *testing.T)  {
+	type testSet struct {
+		input []string
+		expected string
+	}
+
+	var argJob string
+
+	for i, set := range []testSet{
+		{input: []string{"--", "make", "all"}, expected: `Missing argument <job>.`},
+		{input: []string{"job", "extra", "--", "make"}, expected: `Unexpected argument "extra".`},
+	} {
+		_, err := ParseLine(set.input, Spec{}, Arguments{{Name: "job", Holder: &argJob}})
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if set.expected != err.Error() {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+)
+
+// Convert a string into an IP address (ex: "192.168.0.1" or "::1").
+
+func (o *Option) parseIP(inValue string) (net.IP, error) {
+	ip := net.ParseIP(inValue)
+	if nil == ip {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueIPExpected, o.displayValue(inValue), o.getName()))
+	}
+	return ip, nil
+}
+
+// Convert a string into a network, written in CIDR notation (ex: "192.168.0.0/24" or "2001:db8::/32").
+// Please note that the bits of the address that are not part of the network prefix are cleared (ex: "10.1.2.3/8"
+// gives the network "10.0.0.0/8").
+
+func (o *Option) parseIPNet(inValue string) (*net.IPNet, error) {
+	_, network, err := net.ParseCIDR(inValue)
+	if nil != err {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueCIDRExpected, o.displayValue(inValue), o.getName()))
+	}
+	return network, nil
+}
+
+// Convert a string into a network prefix, written in CIDR notation (ex: "192.168.0.0/24" or "2001:db8::/32").
+// Contrary to the function parseIPNet, the address is kept as given (ex: "10.1.2.3/8" gives the prefix "10.1.2.3/8").
+// Use the method netip.Prefix.Masked() to clear the bits that are not part of the prefix.
+
+func (o *Option) parsePrefix(inValue string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(inValue)
+	if nil != err {
+		return netip.Prefix{}, errors.New(fmt.Sprintf(errorInvalidValueCIDRExpected, o.displayValue(inValue), o.getName()))
+	}
+	return prefix, nil
+}
+
+// Convert a string into a pair "IP address" / "port" (ex: "192.168.0.1:8080" or "[::1]:8080").
+
+func (o *Option) parseAddrPort(inValue string) (netip.AddrPort, error) {
+	addrPort, err := netip.ParseAddrPort(inValue)
+	if nil != err {
+		return netip.AddrPort{}, errors.New(fmt.Sprintf(errorInvalidValueAddrPortExpected, o.displayValue(inValue), o.getName()))
+	}
+	return addrPort, nil
+}
+
+// Convert a string into an absolute URL (ex: "https://example.com/path"). The URL must specify a scheme.
+
+func (o *Option) parseURL(inValue string) (*url.URL, error) {
+	u, err := url.Parse(inValue)
+	if nil != err || ! u.IsAbs() {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueURLExpected, o.displayValue(inValue), o.getName()))
+	}
+	return u, nil
+}
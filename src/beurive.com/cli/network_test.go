@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+)
+
+// -----------------------------------------------------------------
+// Test that the types of the network values holders are well detected.
+// -----------------------------------------------------------------
+
+func TestGetTypeNetworkOk(t *testing.T)  {
+	var vIP        net.IP
+	var vIPNet     net.IPNet
+	var vPrefix    netip.Prefix
+	var vAddrPort  netip.AddrPort
+	var vURL       url.URL
+	var vIPs       []net.IP
+	var vIPNets    []net.IPNet
+	var vPrefixes  []netip.Prefix
+	var vAddrPorts []netip.AddrPort
+	var vURLs      []url.URL
+
+	type testSet struct {
+		Value    interface{}
+		Expected typeOption
+	}
+
+	for i, v := range []testSet{
+		{ Value: &vIP,        Expected: TypeIP },
+		{ Value: &vIPNet,     Expected: TypeIPNet },
+		{ Value: &vPrefix,    Expected: TypePrefix },
+		{ Value: &vAddrPort,  Expected: TypeAddrPort },
+		{ Value: &vURL,       Expected: TypeURL },
+		{ Value: &vIPs,       Expected: TypeIPs },
+		{ Value: &vIPNets,    Expected: TypeIPNets },
+		{ Value: &vPrefixes,  Expected: TypePrefixes },
+		{ Value: &vAddrPorts, Expected: TypeAddrPorts },
+		{ Value: &vURLs,      Expected: TypeURLs },
+	} {
+		option := Option{ Short: "n", Long: "network", Holder: v.Value }
+		if err := option.init(); nil != err {
+			t.Errorf(`Unexpected error. Test #%d failed!`, i)
+		} else if ot, _ := option.getType(); v.Expected != ot {
+			t.Errorf(`Unexpected type. Test #%d failed!`, i)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the conversion of network values.
+// -----------------------------------------------------------------
+
+func TestNetworkOk(t *testing.T)  {
+	var cloListen netip.AddrPort
+	var cloAllow []netip.Prefix
+	var cloDeny []net.IPNet
+	var cloBind net.IP
+	var cloEndpoint url.URL
+	var cloMirrors []url.URL
+
+	spec := Spec{
+		Option{Short: "l", Long: "listen",   Holder: &cloListen},
+		Option{Short: "a", Long: "allow",    Holder: &cloAllow},
+		Option{Short: "d", Long: "deny",     Holder: &cloDeny},
+		Option{Short: "b", Long: "bind",     Holder: &cloBind},
+		Option{Short: "e", Long: "endpoint", Holder: &cloEndpoint},
+		Option{Short: "m", Long: "mirror",   Holder: &cloMirrors},
+	}
+
+	input := []string{
+		"--listen", "[::1]:8080",
+		"-a", "10.1.2.3/8", "-a", "2001:db8::/32",
+		"-d", "192.168.1.7/24",
+		"--bind", "127.0.0.1",
+		"--endpoint", "https://example.com/api?v=2",
+		"-m", "http://a.example.com", "-m", "ftp://b.example.com/pub",
+	}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if "[::1]:8080" != cloListen.String() {
+		t.Errorf(`Unexpected value: %s.`, cloListen)
+	}
+	if 2 != len(cloAllow) || "10.1.2.3/8" != cloAllow[0].String() || "2001:db8::/32" != cloAllow[1].String() {
+		t.Errorf(`Unexpected values: %v.`, cloAllow)
+	}
+	if 1 != len(cloDeny) || "192.168.1.0/24" != cloDeny[0].String() {
+		t.Errorf(`Unexpected values: %v.`, cloDeny)
+	}
+	if ! cloBind.Equal(net.IPv4(127, 0, 0, 1)) {
+		t.Errorf(`Unexpected value: %s.`, cloBind)
+	}
+	if "example.com" != cloEndpoint.Host || "/api" != cloEndpoint.Path {
+		t.Errorf(`Unexpected value: %s.`, cloEndpoint.String())
+	}
+	if 2 != len(cloMirrors) || "ftp" != cloMirrors[1].Scheme {
+		t.Errorf(`Unexpected values: %v.`, cloMirrors)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValueNetwork(t *testing.T)  {
+	var cloIP net.IP
+	var cloIPNet net.IPNet
+	var cloPrefixes []netip.Prefix
+	var cloAddrPort netip.AddrPort
+	var cloURL url.URL
+
+	type setType struct {
+		option Option
+		value string
+		expected string
+	}
+	testSet := []setType{
+		{
+			option: Option{Long: "bind", Holder: &cloIP},
+			value: "localhost",
+			expected: fmt.Sprintf(errorInvalidValueIPExpected, "localhost", "bind"),
+		},
+		{
+			option: Option{Long: "deny", Holder: &cloIPNet},
+			value: "10.0.0.0",
+			expected: fmt.Sprintf(errorInvalidValueCIDRExpected, "10.0.0.0", "deny"),
+		},
+		{
+			option: Option{Long: "allow", Holder: &cloPrefixes},
+			value: "10.0.0.0/33",
+			expected: fmt.Sprintf(errorInvalidValueCIDRExpected, "10.0.0.0/33", "allow"),
+		},
+		{
+			option: Option{Long: "listen", Holder: &cloAddrPort},
+			value: "localhost:80",
+			expected: fmt.Sprintf(errorInvalidValueAddrPortExpected, "localhost:80", "listen"),
+		},
+		{
+			option: Option{Long: "endpoint", Holder: &cloURL},
+			value: "example.com/api",
+			expected: fmt.Sprintf(errorInvalidValueURLExpected, "example.com/api", "endpoint"),
+		},
+	}
+
+	for i, set := range testSet {
+		if err := set.option.addValue(set.value); nil == err {
+			t.Errorf(`Test #%d should fail!`, i)
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// This type represents the syntax of the integer values that appear within the command line.
+// - SyntaxDecimal: integers are written in base 10 only. Leading zeros are allowed and they don't mean octal (ex:
+//   "0755" is 755). This is the default syntax.
+// - SyntaxGo: integers are written as GO integer literals. The prefixes "0x" (hexadecimal), "0o" or "0" (octal) and
+//   "0b" (binary) are allowed, as well as underscores between digits (ex: "0xff", "0755", "0b1010" or "1_000_000").
+
+type numericSyntax int
+
+const (
+	SyntaxDecimal numericSyntax = iota
+	SyntaxGo
+)
+
+// Return the base used to convert the integer values of an option, according to the option's numeric syntax.
+// Please note that the base 0 means that the base is given by the prefix of the value (see strconv.ParseInt).
+
+func (o *Option) getBase() int {
+	if SyntaxGo == o.NumericSyntax { return 0 }
+	return 10
+}
+
+// Check that the numeric syntax of an option is valid. A syntax other than the default one can only be specified for
+// integers.
+
+func (o *Option) initNumericSyntax() error {
+	switch o.NumericSyntax {
+		case SyntaxDecimal:
+			return nil
+		case SyntaxGo:
+			if f := o.getFamily(); familySigned == f || familyUnsigned == f { return nil }
+			return errors.New(errorNumericSyntaxUnexpectedHolderType)
+	}
+	return errors.New(fmt.Sprintf(errorInvalidNumericSyntax, o.NumericSyntax))
+}
+
+// Convert a GO numeric value into an arbitrary precision float.
+// Please note that the conversion is exact for all integers (signed or unsigned, including arbitrary precision ones)
+// and for all floats. Arbitrary precision rational numbers are converted with a precision of 256 bits.
+// If the given value is not a numeric value (or if it is NaN), then the function returns the status false.
+
+func toBigFloat(inValue interface{}) (*big.Float, bool) {
+	switch v := inValue.(type) {
+		case int:     return new(big.Float).SetInt64(int64(v)), true
+		case int8:    return new(big.Float).SetInt64(int64(v)), true
+		case int16:   return new(big.Float).SetInt64(int64(v)), true
+		case int32:   return new(big.Float).SetInt64(int64(v)), true
+		case int64:   return new(big.Float).SetInt64(v), true
+		case uint:    return new(big.Float).SetUint64(uint64(v)), true
+		case uint8:   return new(big.Float).SetUint64(uint64(v)), true
+		case uint16:  return new(big.Float).SetUint64(uint64(v)), true
+		case uint32:  return new(big.Float).SetUint64(uint64(v)), true
+		case uint64:  return new(big.Float).SetUint64(v), true
+		case float32: if ! math.IsNaN(float64(v)) { return new(big.Float).SetFloat64(float64(v)), true }
+		case float64: if ! math.IsNaN(v) { return new(big.Float).SetFloat64(v), true }
+		case *big.Int:   if nil != v { return new(big.Float).SetInt(v), true }
+		case *big.Float: if nil != v { return new(big.Float).Copy(v), true }
+		case *big.Rat:   if nil != v { return new(big.Float).SetPrec(256).SetRat(v), true }
+	}
+	return nil, false
+}
+
+// Return a textual representation of the range of values allowed for an option (ex: "[1, 10]", "(0, 1)" or
+// "[1, +inf)").
+
+func (o *Option) getRange() string {
+	left, right := `[`, `]`
+	if o.MinExclusive { left = `(` }
+	if o.MaxExclusive { right = `)` }
+	min, max := `-inf`, `+inf`
+	if nil != o.Min {
+		min = fmt.Sprintf(`%v`, o.Min)
+	} else {
+		left = `(`
+	}
+	if nil != o.Max {
+		max = fmt.Sprintf(`%v`, o.Max)
+	} else {
+		right = `)`
+	}
+	return fmt.Sprintf(`%s%s, %s%s`, left, min, max, right)
+}
+
+// Check that the range of values allowed for an option is valid:
+// - A range can only be specified for numeric options.
+// - Bounds must be GO numeric values.
+// - The range must not be empty.
+
+func (o *Option) initRange() error {
+	if nil == o.Min && nil == o.Max { return nil }
+
+	switch o.getFamily() {
+		case familySigned, familyUnsigned, familyFloat, familyBig:
+		default:
+			return errors.New(errorRangeUnexpectedHolderType)
+	}
+
+	var min, max *big.Float
+	var ok bool
+	if nil != o.Min {
+		if min, ok = toBigFloat(o.Min); ! ok {
+			return errors.New(fmt.Sprintf(errorInvalidRangeBound, o.Min))
+		}
+	}
+	if nil != o.Max {
+		if max, ok = toBigFloat(o.Max); ! ok {
+			return errors.New(fmt.Sprintf(errorInvalidRangeBound, o.Max))
+		}
+	}
+	if nil != min && nil != max {
+		c := min.Cmp(max)
+		if c > 0 || (0 == c && (o.MinExclusive || o.MaxExclusive)) {
+			return errors.New(fmt.Sprintf(errorInvalidRangeEmpty, o.getRange()))
+		}
+	}
+	return nil
+}
+
+// Check that a numeric value is within the range of values allowed for an option.
+// The parameter inValue represents the value as it appears within the command line. It is used to build the error
+// message.
+
+func (o *Option) checkRange(inNumber *big.Float, inValue string) error {
+	if nil != o.Min {
+		min, _ := toBigFloat(o.Min)
+		c := inNumber.Cmp(min)
+		if c < 0 || (0 == c && o.MinExclusive) {
+			return errors.New(fmt.Sprintf(errorInvalidValueOutOfRange, o.displayValue(inValue), o.getName(), o.getRange()))
+		}
+	}
+	if nil != o.Max {
+		max, _ := toBigFloat(o.Max)
+		c := inNumber.Cmp(max)
+		if c > 0 || (0 == c && o.MaxExclusive) {
+			return errors.New(fmt.Sprintf(errorInvalidValueOutOfRange, o.displayValue(inValue), o.getName(), o.getRange()))
+		}
+	}
+	return nil
+}
+
+// Convert a string into a signed integer which size (in bits) is given by the parameter inBitSize.
+// The syntax of the string is given by the option's numeric syntax, or by the option's kind of values (for sizes).
+// The value is checked against the range of values allowed for the option.
+
+func (o *Option) parseInt(inValue string, inBitSize int) (int64, error) {
+	if KindSize == o.Kind {
+		size, err := o.parseSize(inValue, inBitSize, true)
+		if nil != err { return 0, err }
+		if err := o.checkRange(new(big.Float).SetInt(size), inValue); nil != err { return 0, err }
+		return size.Int64(), nil
+	}
+
+	v, err := strconv.ParseInt(inValue, o.getBase(), inBitSize)
+	if nil != err { return 0, o.maskError(err) }
+	if err := o.checkRange(new(big.Float).SetInt64(v), inValue); nil != err { return 0, err }
+	return v, nil
+}
+
+// Convert a string into an unsigned integer which size (in bits) is given by the parameter inBitSize.
+// The syntax of the string is given by the option's numeric syntax, or by the option's kind of values (for sizes).
+// The value is checked against the range of values allowed for the option.
+
+func (o *Option) parseUint(inValue string, inBitSize int) (uint64, error) {
+	if KindSize == o.Kind {
+		size, err := o.parseSize(inValue, inBitSize, false)
+		if nil != err { return 0, err }
+		if err := o.checkRange(new(big.Float).SetInt(size), inValue); nil != err { return 0, err }
+		return size.Uint64(), nil
+	}
+
+	v, err := strconv.ParseUint(inValue, o.getBase(), inBitSize)
+	if nil != err { return 0, o.maskError(err) }
+	if err := o.checkRange(new(big.Float).SetUint64(v), inValue); nil != err { return 0, err }
+	return v, nil
+}
+
+// Convert a string into a float which size (in bits) is given by the parameter inBitSize.
+// The value is checked against the range of values allowed for the option.
+
+func (o *Option) parseFloat(inValue string, inBitSize int) (float64, error) {
+	v, err := strconv.ParseFloat(inValue, inBitSize)
+	if nil != err { return 0, o.maskError(err) }
+	if math.IsNaN(v) {
+		// NaN cannot be compared. It is never within a range.
+		if nil != o.Min || nil != o.Max {
+			return 0, errors.New(fmt.Sprintf(errorInvalidValueOutOfRange, o.displayValue(inValue), o.getName(), o.getRange()))
+		}
+		return v, nil
+	}
+	if err := o.checkRange(new(big.Float).SetFloat64(v), inValue); nil != err { return 0, err }
+	return v, nil
+}
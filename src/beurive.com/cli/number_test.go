@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+)
+
+// -----------------------------------------------------------------
+// Test the restriction of numeric values to a range.
+// -----------------------------------------------------------------
+
+func TestRangeOk(t *testing.T)  {
+	var cloPort uint16
+	var cloRatio float64
+	var cloLevels []int8
+	var cloBig uint64
+
+	spec := Spec{
+		Option{Short: "p", Long: "port",  Holder: &cloPort,   Min: 1, Max: 65535},
+		Option{Short: "r", Long: "ratio", Holder: &cloRatio,  Min: 0, Max: 1, MinExclusive: true},
+		Option{Short: "l", Long: "level", Holder: &cloLevels, Min: -2, Max: 2},
+		Option{Short: "b", Long: "big",   Holder: &cloBig,    Min: uint64(18446744073709551614)},
+	}
+
+	input := []string{"-p", "8080", "--ratio", "1", "-l", "0", "-l", "2", "--big", "18446744073709551615"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Errorf(`Unexpected error: %s`, err.Error())
+	}
+	if 8080 != cloPort || 1 != cloRatio || 2 != len(cloLevels) || 18446744073709551615 != cloBig {
+		t.Errorf(`Unexpected values: %d, %f, %v, %d.`, cloPort, cloRatio, cloLevels, cloBig)
+	}
+}
+
+func TestGetRange(t *testing.T)  {
+	var cloValue int
+
+	type setType struct {
+		option   Option
+		expected string
+	}
+
+	testSet := []setType{
+		{ option: Option{Long: "v", Holder: &cloValue, Min: 1, Max: 10},                                         expected: "[1, 10]" },
+		{ option: Option{Long: "v", Holder: &cloValue, Min: 0, Max: 1, MinExclusive: true, MaxExclusive: true},  expected: "(0, 1)" },
+		{ option: Option{Long: "v", Holder: &cloValue, Min: 1},                                                  expected: "[1, +inf)" },
+		{ option: Option{Long: "v", Holder: &cloValue, Max: 0.5, MaxExclusive: true},                            expected: "(-inf, 0.5)" },
+	}
+
+	for i, set := range testSet {
+		if got := set.option.getRange(); got != set.expected {
+			t.Errorf(`Test #%d failed. Got "%s", expected "%s".`, i, got, set.expected)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValueOutOfRange(t *testing.T)  {
+	var cloPort uint16
+	var cloRatio float32
+	var cloLevels []int
+
+	type setType struct {
+		spec Spec
+		input []string
+		expected string
+	}
+	testSet := []setType{
+		{
+			spec: Spec{ Option{Short: "p", Long: "port", Holder: &cloPort, Min: 1024} },
+			input: []string{ "--port", "80" },
+			expected: fmt.Sprintf(errorInvalidValueOutOfRange, "80", "port", "[1024, +inf)"),
+		},
+		{
+			spec: Spec{ Option{Short: "r", Long: "", Holder: &cloRatio, Min: 0, Max: 1, MaxExclusive: true} },
+			input: []string{ "-r", "1" },
+			expected: fmt.Sprintf(errorInvalidValueOutOfRange, "1", "r", "[0, 1)"),
+		},
+		{
+			spec: Spec{ Option{Short: "r", Long: "", Holder: &cloRatio, Min: 0, Max: 1} },
+			input: []string{ "-r", "NaN" },
+			expected: fmt.Sprintf(errorInvalidValueOutOfRange, "NaN", "r", "[0, 1]"),
+		},
+		{
+			spec: Spec{ Option{Short: "l", Long: "level", Holder: &cloLevels, Max: 3} },
+			input: []string{ "-l", "1", "-l", "4" },
+			expected: fmt.Sprintf(errorInvalidValueOutOfRange, "4", "level", "(-inf, 3]"),
+		},
+	}
+
+	for i, set := range testSet {
+		if _, _, err := Parse(set.input, set.spec); nil == err {
+			t.Errorf(`The test number %d should NOT be OK (%s)`, i, strings.Join(set.input, " "))
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
+
+func TestEM_InvalidRangeDefinition(t *testing.T)  {
+	var cloName string
+	var cloValue int
+
+	type setType struct {
+		option Option
+		expected string
+	}
+	testSet := []setType{
+		{
+			option: Option{Long: "name", Holder: &cloName, Min: 1},
+			expected: errorRangeUnexpectedHolderType,
+		},
+		{
+			option: Option{Long: "value", Holder: &cloValue, Min: "1"},
+			expected: fmt.Sprintf(errorInvalidRangeBound, "1"),
+		},
+		{
+			option: Option{Long: "value", Holder: &cloValue, Min: 10, Max: 1},
+			expected: fmt.Sprintf(errorInvalidRangeEmpty, "[10, 1]"),
+		},
+		{
+			option: Option{Long: "value", Holder: &cloValue, Min: 1, Max: 1, MaxExclusive: true},
+			expected: fmt.Sprintf(errorInvalidRangeEmpty, "[1, 1)"),
+		},
+	}
+
+	for i, set := range testSet {
+		if err := set.option.init(); nil == err {
+			t.Errorf(`Test #%d: the option's specifier should not be valid!`, i)
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the numeric syntaxes.
+// -----------------------------------------------------------------
+
+func TestNumericSyntaxOk(t *testing.T)  {
+	var cloMask uint32
+	var cloPerm uint16
+	var cloCount int
+	var cloFlags []uint8
+	var cloLevel int
+
+	spec := Spec{
+		Option{Short: "m", Long: "mask",  Holder: &cloMask,  NumericSyntax: SyntaxGo},
+		Option{Short: "p", Long: "perm",  Holder: &cloPerm,  NumericSyntax: SyntaxGo},
+		Option{Short: "c", Long: "count", Holder: &cloCount, NumericSyntax: SyntaxGo},
+		Option{Short: "f", Long: "flag",  Holder: &cloFlags, NumericSyntax: SyntaxGo, Choices: []string{"0x1", "0x2"}},
+		Option{Short: "l", Long: "level", Holder: &cloLevel},
+	}
+
+	input := []string{"--mask", "0xff", "--perm", "0755", "-c", "1_000_000", "-f", "0b10", "-f", "1", "-l", "010"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if 0xff != cloMask || 0755 != cloPerm || 1000000 != cloCount || 10 != cloLevel {
+		t.Errorf(`Unexpected values: %d, %d, %d, %d.`, cloMask, cloPerm, cloCount, cloLevel)
+	}
+	if 2 != len(cloFlags) || 2 != cloFlags[0] || 1 != cloFlags[1] {
+		t.Errorf(`Unexpected values: %v.`, cloFlags)
+	}
+}
+
+func TestNumericSyntaxKo(t *testing.T)  {
+	var cloPerm uint16
+
+	// By default, the syntax is strictly decimal.
+	spec := Spec{ Option{Short: "p", Long: "perm", Holder: &cloPerm} }
+	if _, _, err := Parse([]string{"--perm", "0x1ff"}, spec); nil == err {
+		t.Error(`The test should fail: hexadecimal values are not allowed by default.`)
+	}
+}
+
+func TestEM_NumericSyntaxUnexpectedHolderType(t *testing.T)  {
+	var cloRatio float64
+
+	o := Option{Short: "r", Long: "ratio", Holder: &cloRatio, NumericSyntax: SyntaxGo}
+	if err := o.init(); nil == err {
+		t.Error("Option's specifier should not be valid!")
+	} else {
+		if 0 != strings.Compare(err.Error(), errorNumericSyntaxUnexpectedHolderType) {
+			t.Errorf(`Test failed. Got [%s] / [%s]`, err.Error(), errorNumericSyntaxUnexpectedHolderType)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Check that the limits on the number of occurrences of an option are valid:
+// - The limits must not be negative, and the minimum must not be greater than the maximum.
+// - An option that can appear only once (a flag or a singleton) cannot be required to appear more than once.
+
+func (o *Option) initOccurrences() error {
+	if 0 == o.MinOccurrences && 0 == o.MaxOccurrences { return nil }
+
+	if o.MinOccurrences < 0 || o.MaxOccurrences < 0 || (o.MaxOccurrences > 0 && o.MinOccurrences > o.MaxOccurrences) {
+		return errors.New(fmt.Sprintf(errorInvalidOccurrences, o.MinOccurrences, o.MaxOccurrences))
+	}
+	if o.isSingleton() && (o.MinOccurrences > 1 || o.MaxOccurrences > 1) {
+		return errors.New(errorOccurrencesUnexpectedHolderType)
+	}
+	return nil
+}
+
+// Check that the number of occurrences of all options is within the limits defined by the specification.
+// Please note that default values are not occurrences.
+
+func checkOccurrences(inSpec Spec) error {
+	for i := range inSpec {
+		o := &inSpec[i]
+		if o.occurrences < o.MinOccurrences {
+			return errors.New(fmt.Sprintf(errorTooFewOccurrences, o.getSpecifier(), o.MinOccurrences, o.occurrences))
+		}
+		if o.MaxOccurrences > 0 && o.occurrences > o.MaxOccurrences {
+			return errors.New(fmt.Sprintf(errorTooManyOccurrences, o.getSpecifier(), o.MaxOccurrences, o.occurrences))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+)
+
+// -----------------------------------------------------------------
+// Test the limits on the numbers of occurrences.
+// -----------------------------------------------------------------
+
+func TestOccurrencesOk(t *testing.T)  {
+	var cloMirrors []string
+	var cloHosts []string
+	var cloVerbose bool
+
+	spec := Spec{
+		Option{Short: "m", Long: "mirror",  Holder: &cloMirrors, MaxOccurrences: 3},
+		Option{Short: "h", Long: "host",    Holder: &cloHosts, MinOccurrences: 1, Default: []string{"localhost"}},
+		Option{Short: "v", Long: "verbose", Holder: &cloVerbose, MaxOccurrences: 1},
+	}
+
+	for i, input := range [][]string{
+		{"-h", "a"},
+		{"-h", "a", "--host", "b", "-m", "x", "-m", "y", "--mirror", "z", "-v"},
+	} {
+		if _, _, err := Parse(input, spec); nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_OccurrencesKo(t *testing.T)  {
+	type testSet struct {
+		option Option
+		input []string
+		expected string
+	}
+
+	var cloMirrors []string
+	var cloHost string
+
+	for i, set := range []testSet{
+		{option: Option{Long: "mirror", Holder: &cloMirrors, MinOccurrences: -1},
+			expected: `Invalid option definition: invalid numbers of occurrences (minimum: -1, maximum: 0).`},
+		{option: Option{Long: "mirror", Holder: &cloMirrors, MinOccurrences: 3, MaxOccurrences: 2},
+			expected: `Invalid option definition: invalid numbers of occurrences (minimum: 3, maximum: 2).`},
+		{option: Option{Long: "host", Holder: &cloHost, MaxOccurrences: 2},
+			expected: errorOccurrencesUnexpectedHolderType},
+		{option: Option{Long: "mirror", Holder: &cloMirrors, MaxOccurrences: 2},
+			input: []string{"--mirror", "a", "--mirror", "b", "--mirror", "c"},
+			expected: `The option --mirror must appear at most 2 time(s) (found 3).`},
+		{option: Option{Long: "mirror", Holder: &cloMirrors, MinOccurrences: 2, Default: []string{"a", "b"}},
+			input: []string{"--mirror", "a"},
+			expected: `The option --mirror must appear at least 2 time(s) (found 1).`},
+		{option: Option{Short: "m", Holder: &cloMirrors, MinOccurrences: 1},
+			input: []string{},
+			expected: `The option -m must appear at least 1 time(s) (found 0).`},
+	} {
+		_, _, err := Parse(set.input, Spec{ set.option })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
@@ -1,471 +1,1073 @@
-package cli
-
-import (
-	"errors"
-	"fmt"
-	"strconv"
-)
-
-// The type Option represents an option within the command line.
-// An option is represented by the elements listed below:
-//
-// - an optional short name (or nickname).
-// - an optional long name (or full name).
-// - a mandatory "value holder". The value holder is a pointer to a variable used to store the value defined by the option.
-//
-// Within the command line, short names are prefixed by a single dash (ex: -i).
-// Within the command line, long names are prefixed by a double dash (ex: --input).
-// Value holders points to variables which types may be:
-//
-// - bool: this type is used to store the "state" (set or unset) of an option that does not require a value (a flag or
-//   a switch).
-// - string: this type is used to store the value of an option that defines a string.
-// - int: this type is used to store the value of an option that defines an integer.
-// - []string: this type is used to store a list of strings. Each element of the list comes from one occurrence of the
-//   option's name, within the command line (ex: "--path=/usr/bin --path=/bin" will be stored as "[]string{`/usr/bin`, `/bin`}").
-// - []int: this type is used to store a list of integers. Each element of the list comes from one occurrence of the
-//   option's name, within the command line (ex: "--level=0 --level=1" will be stored as "[]int{0, 1}").
-
-// This type represents the "GO type" of the option's value holder.
-
-type typeOption int
-
-const (
-	TypeUnexpected typeOption = iota
-	TypeBool
-	TypeString
-	TypeInteger
-	TypeInteger8
-	TypeInteger16
-	TypeInteger32
-	TypeInteger64
-	TypeFloat32
-	TypeFloat64
-	TypeStrings
-	TypeIntegers
-	TypeIntegers8
-	TypeIntegers16
-	TypeIntegers32
-	TypeIntegers64
-	TypeFloats32
-	TypeFloats64
-
-	TypeUInteger
-	TypeUInteger8
-	TypeUInteger16
-	TypeUInteger32
-	TypeUInteger64
-
-	TypeUIntegers
-	TypeUIntegers8
-	TypeUIntegers16
-	TypeUIntegers32
-	TypeUIntegers64
-)
-
-// This type defines the constraints that apply to a type of option's value holder.
-
-type typeConstraints struct {
-	singleton bool
-	value bool
-}
-
-// For all "GO types" that can be used as options' holders, this map defines the following constraints:
-// - Can the option appears more than once within the command line ?
-// - Does the option accept value(s) ?
-
-var typesConstraints = map[typeOption]typeConstraints{
-	TypeBool:        {singleton:true,  value:false},
-	TypeString:      {singleton:true,  value:true},
-	TypeInteger:     {singleton:true,  value:true},
-	TypeInteger8:    {singleton:true,  value:true},
-	TypeInteger16:   {singleton:true,  value:true},
-	TypeInteger32:   {singleton:true,  value:true},
-	TypeInteger64:   {singleton:true,  value:true},
-	TypeFloat32:     {singleton:true,  value:true},
-	TypeFloat64:     {singleton:true,  value:true},
-	TypeStrings:     {singleton:false, value:true},
-	TypeIntegers:    {singleton:false, value:true},
-	TypeIntegers8:   {singleton:false, value:true},
-	TypeIntegers16:  {singleton:false, value:true},
-	TypeIntegers32:  {singleton:false, value:true},
-	TypeIntegers64:  {singleton:false, value:true},
-	TypeFloats32:    {singleton:false, value:true},
-	TypeFloats64:    {singleton:false, value:true},
-
-	TypeUInteger:    {singleton:true,  value:true},
-	TypeUInteger8:   {singleton:true,  value:true},
-	TypeUInteger16:  {singleton:true,  value:true},
-	TypeUInteger32:  {singleton:true,  value:true},
-	TypeUInteger64:  {singleton:true,  value:true},
-
-	TypeUIntegers:   {singleton:false, value:true},
-	TypeUIntegers8:  {singleton:false, value:true},
-	TypeUIntegers16: {singleton:false, value:true},
-	TypeUIntegers32: {singleton:false, value:true},
-	TypeUIntegers64: {singleton:false, value:true},
-}
-
-// This structure defines an option.
-// * The attribute "Short" represents the short name of the option. The short name is made of one, and only one, character.
-// * The attribute "Long" represents the long name of the option. The long name is made of one or more characters.
-// * The attribute "Holder" contains a pointer to the required data type. Please note that this pointer may point to an
-//   allocated variable or not. In the latter case, the variable will be allocated for you.
-// * The attribute "set" indicates whether the option is set or not.
-//   The value true indicates that the option is set.
-//   The value false indicates that the option is not set.
-
-type Option struct {
-	Short string        // The option's short name.
-	Long string         // The option's long name.
-	Holder interface{}  // pointer to the option's value holder.
-	set bool            // The flag that specifies whether the option is set or not.
-}
-
-// Test whether an option is set or not.
-// If the option is set, then the function returns the value true.
-// Otherwise, it returns the value false.
-
-func (o *Option) isSet() bool {
-	return o.set
-}
-
-// Declare an option as being set.
-
-func (o *Option) setIt() {
-	o.set = true
-}
-
-// Add a value to an option.
-// Please note that the parameter inValue may be a string or a boolean.
-
-func (o *Option) addValue(inValue interface{}) error {
-
-	typeOption, _ := o.getType()
-
-	if TypeBool == typeOption {
-		if v, ok := inValue.(bool); ! ok {
-			return errors.New(errorInvalidValueBoolExpected)
-		} else {
-			p, _ := o.Holder.(*bool)
-			if nil == p {
-				o.Holder = new(bool)
-				p, _ = o.Holder.(*bool)
-			}
-			*p = v
-		}
-		return nil
-	}
-
-	v, ok := inValue.(string);
-	if ! ok {
-		return errors.New(errorInvalidValueStringExpected)
-	}
-
-	switch typeOption {
-		case TypeString:
-			p, _ := o.Holder.(*string)
-			if nil == p {
-				o.Holder = new(string)
-				p, _ = o.Holder.(*string)
-			}
-			*p = v
-		case TypeInteger:
-			// Be careful, the variable "p" is a copy of the variable "o.Holder".
-			// Affecting a value to "p" does not modify the value of "o.Holder".
-			p, _ := o.Holder.(*int)
-
-			// Be careful, "o.Holder" is an interface.
-			// We cannot directly compare an interface's against nil.
-			if nil == p {
-				o.Holder = new(int)
-				p, _ = o.Holder.(*int) // The value of "o.Holder" changed!
-			}
-			v, err := strconv.ParseInt(v, 10, 0)
-			if nil != err { return err }
-			*p = int(v)
-		case TypeInteger8:
-			p, _ := o.Holder.(*int8)
-			if nil == p {
-				o.Holder = new(int8)
-				p, _ = o.Holder.(*int8)
-			}
-			v, err := strconv.ParseInt(v, 10, 8)
-			if nil != err { return err }
-			*p = int8(v)
-		case TypeInteger16:
-			p, _ := o.Holder.(*int16)
-			if nil == p {
-				o.Holder = new(int16)
-				p, _ = o.Holder.(*int16)
-			}
-			v, err := strconv.ParseInt(v, 10, 16)
-			if nil != err { return err }
-			*p = int16(v)
-		case TypeInteger32:
-			p, _ := o.Holder.(*int32)
-			if nil == p {
-				o.Holder = new(int32)
-				p, _ = o.Holder.(*int32)
-			}
-			v, err := strconv.ParseInt(v, 10, 32)
-			if nil != err { return err }
-			*p = int32(v)
-		case TypeInteger64:
-			p, _ := o.Holder.(*int64)
-			if nil == p {
-				o.Holder = new(int64)
-				p, _ = o.Holder.(*int64)
-			}
-			v, err := strconv.ParseInt(v, 10, 64)
-			if nil != err { return err }
-			*p = v
-		case TypeUInteger:
-			p, _ := o.Holder.(*uint)
-			if nil == p {
-				o.Holder = new(uint)
-				p, _ = o.Holder.(*uint) // The value of "o.Holder" changed!
-			}
-			v, err := strconv.ParseUint(v, 10, 0)
-			if nil != err { return err }
-			*p = uint(v)
-		case TypeUInteger8:
-			p, _ := o.Holder.(*uint8)
-			if nil == p {
-				o.Holder = new(uint8)
-				p, _ = o.Holder.(*uint8)
-			}
-			v, err := strconv.ParseUint(v, 10, 8)
-			if nil != err { return err }
-			*p = uint8(v)
-		case TypeUInteger16:
-			p, _ := o.Holder.(*uint16)
-			if nil == p {
-				o.Holder = new(uint16)
-				p, _ = o.Holder.(*uint16)
-			}
-			v, err := strconv.ParseUint(v, 10, 16)
-			if nil != err { return err }
-			*p = uint16(v)
-		case TypeUInteger32:
-			p, _ := o.Holder.(*uint32)
-			if nil == p {
-				o.Holder = new(uint32)
-				p, _ = o.Holder.(*uint32)
-			}
-			v, err := strconv.ParseUint(v, 10, 32)
-			if nil != err { return err }
-			*p = uint32(v)
-		case TypeUInteger64:
-			p, _ := o.Holder.(*uint64)
-			if nil == p {
-				o.Holder = new(uint64)
-				p, _ = o.Holder.(*uint64)
-			}
-			v, err := strconv.ParseUint(v, 10, 64)
-			if nil != err { return err }
-			*p = v
-		case TypeFloat32:
-			p, _ := o.Holder.(*float32)
-			if nil == p {
-				o.Holder = new(float32)
-				p, _ = o.Holder.(*float32)
-			}
-			v, err := strconv.ParseFloat(v, 32)
-			if nil != err { return err }
-			*p = float32(v)
-		case TypeFloat64:
-			p, _ := o.Holder.(*float64)
-			if nil == p {
-				o.Holder = new(float64)
-				p, _ = o.Holder.(*float64)
-			}
-			v, err := strconv.ParseFloat(v, 64)
-			if nil != err { return err }
-			*p = v
-		case TypeStrings:
-			p, _ := o.Holder.(*[]string)
-			if nil == p { *p = make([]string, 0) }
-			*p = append(*p, v)
-
-
-		case TypeIntegers:
-			v, err := strconv.ParseInt(v, 10, 0)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]int)
-			if nil == p { *p = make([]int, 0) }
-			*p = append(*p, int(v))
-		case TypeIntegers8:
-			v, err := strconv.ParseInt(v, 10, 8)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]int8)
-			if nil == p { *p = make([]int8, 0) }
-			*p = append(*p, int8(v))
-		case TypeIntegers16:
-			v, err := strconv.ParseInt(v, 10, 16)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]int16)
-			if nil == p { *p = make([]int16, 0) }
-			*p = append(*p, int16(v))
-		case TypeIntegers32:
-			v, err := strconv.ParseInt(v, 10, 32)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]int32)
-			if nil == p { *p = make([]int32, 0) }
-			*p = append(*p, int32(v))
-		case TypeIntegers64:
-			v, err := strconv.ParseInt(v, 10, 64)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]int64)
-			if nil == p { *p = make([]int64, 0) }
-			*p = append(*p, v)
-		case TypeUIntegers:
-			v, err := strconv.ParseUint(v, 10, 0)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]uint)
-			if nil == p { *p = make([]uint, 0) }
-			*p = append(*p, uint(v))
-		case TypeUIntegers8:
-			v, err := strconv.ParseUint(v, 10, 8)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]uint8)
-			if nil == p { *p = make([]uint8, 0) }
-			*p = append(*p, uint8(v))
-		case TypeUIntegers16:
-			v, err := strconv.ParseUint(v, 10, 16)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]uint16)
-			if nil == p { *p = make([]uint16, 0) }
-			*p = append(*p, uint16(v))
-		case TypeUIntegers32:
-			v, err := strconv.ParseUint(v, 10, 32)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]uint32)
-			if nil == p { *p = make([]uint32, 0) }
-			*p = append(*p, uint32(v))
-		case TypeUIntegers64:
-			v, err := strconv.ParseUint(v, 10, 64)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]uint64)
-			if nil == p { *p = make([]uint64, 0) }
-			*p = append(*p, v)
-		case TypeFloats32:
-			v, err := strconv.ParseFloat(v, 32)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]float32)
-			if nil == p { *p = make([]float32, 0) }
-			*p = append(*p, float32(v))
-		case TypeFloats64:
-			v, err := strconv.ParseFloat(v, 64)
-			if nil != err { return err }
-			p, _ := o.Holder.(*[]float64)
-			if nil == p { *p = make([]float64, 0) }
-			*p = append(*p, v)
-	}
-	return nil
-}
-
-// Test whether an option can appear only once within the command line or not.
-
-func (o *Option) isSingleton() bool {
-	t, _ := o.getType();
-	return typesConstraints[t].singleton
-}
-
-// Test whether an option requires a value or not.
-
-func (o *Option) requireValue() bool {
-	t, _ := o.getType();
-	return typesConstraints[t].value
-}
-
-// Return the long name associated to an option, if it exists.
-
-func (o *Option) getLong() (string, bool) {
-	if "" != o.Long { return o.Long, true }
-	return "", false
-}
-
-// Return the short name associated to an option, if it exists.
-
-func (o *Option) getShort() (string, bool) {
-	if "" != o.Short { return o.Short, true }
-	return "", false
-}
-
-// Initialise an option. The initialisation consists of the actions listed below:
-// - Checks that at least one name is specified (the short one or the long one).
-// - Checks that the type of the variable used to store the option's value is valid.
-// - Initialises the value of the option, in the case of an option that does not take values.
-// - Initialises the state (set or unset) of the option. the state is initialised to the value false (unset).
-
-func (o Option) init() error {
-
-	if "" == o.Short && "" == o.Long {
-		return errors.New(errorInvalidOptionSpecificationNoName)
-	}
-	if "" != o.Short {
-		if len(o.Short) > 1 {
-			return errors.New(fmt.Sprintf(errorShortNameTooLong, o.Short))
-		}
-		if ok, _ := isOptionShort(o.Short); ! ok {
-			return errors.New(fmt.Sprintf(errorShortNameUnexpectedCharacter, o.Short))
-		}
-	}
-	if "" != o.Long {
-		if ok, _ := isOptionLong(o.Long); ! ok {
-			return errors.New(fmt.Sprintf(errorLongNameUnexpectedCharacter, o.Long))
-		}
-	}
-	if _, err := o.getType(); nil != err {
-		return errors.New(errorInvalidOptionSpecificationUnexpectedHolderType)
-	}
-
-	o.set = false
-	if t, _ := o.getType(); TypeBool == t {
-		p, _ := o.Holder.(*bool)
-		*p = false
-	}
-
-	return nil
-}
-
-// Return the type of the variable used to store the option's value.
-// This type defines the constraints that apply to the option's value.
-
-func (o Option) getType() (typeOption, error) {
-	if _, ok := o.Holder.(*bool); ok { return TypeBool, nil }
-
-	// Single value
-	if _, ok := o.Holder.(*string);    ok { return TypeString,      nil }
-	if _, ok := o.Holder.(*int);       ok { return TypeInteger,     nil }
-	if _, ok := o.Holder.(*int8);      ok { return TypeInteger8,    nil }
-	if _, ok := o.Holder.(*int16);     ok { return TypeInteger16,   nil }
-	if _, ok := o.Holder.(*int32);     ok { return TypeInteger32,   nil }
-	if _, ok := o.Holder.(*int64);     ok { return TypeInteger64,   nil }
-	if _, ok := o.Holder.(*float32);   ok { return TypeFloat32,     nil }
-	if _, ok := o.Holder.(*float64);   ok { return TypeFloat64,     nil }
-	if _, ok := o.Holder.(*uint);      ok { return TypeUInteger,    nil }
-	if _, ok := o.Holder.(*uint8);     ok { return TypeUInteger8,   nil }
-	if _, ok := o.Holder.(*uint16);    ok { return TypeUInteger16,  nil }
-	if _, ok := o.Holder.(*uint32);    ok { return TypeUInteger32,  nil }
-	if _, ok := o.Holder.(*uint64);    ok { return TypeUInteger64,  nil }
-
-	// Multiple values
-	if _, ok := o.Holder.(*[]string);  ok { return TypeStrings,     nil }
-	if _, ok := o.Holder.(*[]int);     ok { return TypeIntegers,    nil }
-	if _, ok := o.Holder.(*[]int8);    ok { return TypeIntegers8,   nil }
-	if _, ok := o.Holder.(*[]int16);   ok { return TypeIntegers16,  nil }
-	if _, ok := o.Holder.(*[]int32);   ok { return TypeIntegers32,  nil }
-	if _, ok := o.Holder.(*[]int64);   ok { return TypeIntegers64,  nil }
-	if _, ok := o.Holder.(*[]float32); ok { return TypeFloats32,    nil }
-	if _, ok := o.Holder.(*[]float64); ok { return TypeFloats64,    nil }
-	if _, ok := o.Holder.(*[]uint);    ok { return TypeUIntegers,   nil }
-	if _, ok := o.Holder.(*[]uint8);   ok { return TypeUIntegers8,  nil }
-	if _, ok := o.Holder.(*[]uint16);  ok { return TypeUIntegers16, nil }
-	if _, ok := o.Holder.(*[]uint32);  ok { return TypeUIntegers32, nil }
-	if _, ok := o.Holder.(*[]uint64);  ok { return TypeUIntegers64, nil }
-
-	return TypeUnexpected, errors.New(errorUnexpectedType)
-}
-
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+)
+
+// The type Option represents an option within the command line.
+// An option is represented by the elements listed below:
+//
+// - an optional short name (or nickname).
+// - an optional long name (or full name).
+// - a mandatory "value holder". The value holder is a pointer to a variable used to store the value defined by the option.
+//
+// Within the command line, short names are prefixed by a single dash (ex: -i).
+// Within the command line, long names are prefixed by a double dash (ex: --input).
+// Value holders points to variables which types may be:
+//
+// - bool: this type is used to store the "state" (set or unset) of an option that does not require a value (a flag or
+//   a switch).
+// - string: this type is used to store the value of an option that defines a string.
+// - int: this type is used to store the value of an option that defines an integer.
+// - []string: this type is used to store a list of strings. Each element of the list comes from one occurrence of the
+//   option's name, within the command line (ex: "--path=/usr/bin --path=/bin" will be stored as "[]string{`/usr/bin`, `/bin`}").
+// - []int: this type is used to store a list of integers. Each element of the list comes from one occurrence of the
+//   option's name, within the command line (ex: "--level=0 --level=1" will be stored as "[]int{0, 1}").
+// - net.IP, net.IPNet, netip.Prefix, netip.AddrPort and url.URL (and lists of these types): these types are used to
+//   store network values (IP addresses, networks in CIDR notation, "address:port" pairs and absolute URLs).
+// - *os.File: this type is used to store a file opened from a path (see the kind of values KindPath).
+// - big.Int, big.Float and big.Rat (and lists of pointers to these types, ex: []*big.Int): these types are used to
+//   store arbitrary precision numbers (see the attributes "Base" and "Precision").
+// - regexp.Regexp (and lists of pointers to this type, ex: []*regexp.Regexp): this type is used to store compiled
+//   regular expressions (ex: "--include '^api/.*'").
+// - pointers to all the types above (ex: *int or *[]string): the pointer stays nil if the option does not appear
+//   within the command line. Thus, the value holder can distinguish "--retries 0" from an absent option. Please note
+//   that the value holder is a pointer to such a pointer (ex: **int).
+// - []byte: by default, this type is used to store a list of unsigned 8-bit integers (see []int). If the option defines
+//   an encoding (ex: EncodingHex), then this type is used to store the decoded value (ex: a key or a salt).
+
+// This type represents the "GO type" of the option's value holder.
+
+type typeOption int
+
+const (
+	TypeUnexpected typeOption = iota
+	TypeBool
+	TypeString
+	TypeInteger
+	TypeInteger8
+	TypeInteger16
+	TypeInteger32
+	TypeInteger64
+	TypeFloat32
+	TypeFloat64
+	TypeStrings
+	TypeIntegers
+	TypeIntegers8
+	TypeIntegers16
+	TypeIntegers32
+	TypeIntegers64
+	TypeFloats32
+	TypeFloats64
+
+	TypeUInteger
+	TypeUInteger8
+	TypeUInteger16
+	TypeUInteger32
+	TypeUInteger64
+
+	TypeUIntegers
+	TypeUIntegers8
+	TypeUIntegers16
+	TypeUIntegers32
+	TypeUIntegers64
+
+	TypeIP
+	TypeIPNet
+	TypePrefix
+	TypeAddrPort
+	TypeURL
+
+	TypeIPs
+	TypeIPNets
+	TypePrefixes
+	TypeAddrPorts
+	TypeURLs
+
+	TypeFile
+
+	TypeBytes
+
+	TypeJSON
+
+	TypeBigInt
+	TypeBigFloat
+	TypeBigRat
+
+	TypeBigInts
+	TypeBigFloats
+	TypeBigRats
+
+	TypeRegexp
+	TypeRegexps
+)
+
+// This type represents the family of values stored by a type of option's value holder.
+
+type typeFamily int
+
+const (
+	familyNone typeFamily = iota
+	familyString
+	familySigned
+	familyUnsigned
+	familyFloat
+	familyNetwork
+	familyFile
+	familyBytes
+	familyJSON
+	familyBig
+	familyRegexp
+)
+
+// This type defines the constraints that apply to a type of option's value holder.
+
+type typeConstraints struct {
+	singleton bool
+	value bool
+	family typeFamily
+}
+
+// For all "GO types" that can be used as options' holders, this map defines the following constraints:
+// - Can the option appears more than once within the command line ?
+// - Does the option accept value(s) ?
+// - What is the family of the values (strings, signed integers, unsigned integers, floats or network values) ?
+
+var typesConstraints = map[typeOption]typeConstraints{
+	TypeBool:        {singleton:true,  value:false, family:familyNone},
+	TypeString:      {singleton:true,  value:true,  family:familyString},
+	TypeInteger:     {singleton:true,  value:true,  family:familySigned},
+	TypeInteger8:    {singleton:true,  value:true,  family:familySigned},
+	TypeInteger16:   {singleton:true,  value:true,  family:familySigned},
+	TypeInteger32:   {singleton:true,  value:true,  family:familySigned},
+	TypeInteger64:   {singleton:true,  value:true,  family:familySigned},
+	TypeFloat32:     {singleton:true,  value:true,  family:familyFloat},
+	TypeFloat64:     {singleton:true,  value:true,  family:familyFloat},
+	TypeStrings:     {singleton:false, value:true,  family:familyString},
+	TypeIntegers:    {singleton:false, value:true,  family:familySigned},
+	TypeIntegers8:   {singleton:false, value:true,  family:familySigned},
+	TypeIntegers16:  {singleton:false, value:true,  family:familySigned},
+	TypeIntegers32:  {singleton:false, value:true,  family:familySigned},
+	TypeIntegers64:  {singleton:false, value:true,  family:familySigned},
+	TypeFloats32:    {singleton:false, value:true,  family:familyFloat},
+	TypeFloats64:    {singleton:false, value:true,  family:familyFloat},
+
+	TypeUInteger:    {singleton:true,  value:true,  family:familyUnsigned},
+	TypeUInteger8:   {singleton:true,  value:true,  family:familyUnsigned},
+	TypeUInteger16:  {singleton:true,  value:true,  family:familyUnsigned},
+	TypeUInteger32:  {singleton:true,  value:true,  family:familyUnsigned},
+	TypeUInteger64:  {singleton:true,  value:true,  family:familyUnsigned},
+
+	TypeUIntegers:   {singleton:false, value:true,  family:familyUnsigned},
+	TypeUIntegers8:  {singleton:false, value:true,  family:familyUnsigned},
+	TypeUIntegers16: {singleton:false, value:true,  family:familyUnsigned},
+	TypeUIntegers32: {singleton:false, value:true,  family:familyUnsigned},
+	TypeUIntegers64: {singleton:false, value:true,  family:familyUnsigned},
+
+	TypeIP:          {singleton:true,  value:true,  family:familyNetwork},
+	TypeIPNet:       {singleton:true,  value:true,  family:familyNetwork},
+	TypePrefix:      {singleton:true,  value:true,  family:familyNetwork},
+	TypeAddrPort:    {singleton:true,  value:true,  family:familyNetwork},
+	TypeURL:         {singleton:true,  value:true,  family:familyNetwork},
+
+	TypeIPs:         {singleton:false, value:true,  family:familyNetwork},
+	TypeIPNets:      {singleton:false, value:true,  family:familyNetwork},
+	TypePrefixes:    {singleton:false, value:true,  family:familyNetwork},
+	TypeAddrPorts:   {singleton:false, value:true,  family:familyNetwork},
+	TypeURLs:        {singleton:false, value:true,  family:familyNetwork},
+
+	TypeFile:        {singleton:true,  value:true,  family:familyFile},
+
+	TypeBytes:       {singleton:true,  value:true,  family:familyBytes},
+
+	TypeJSON:        {singleton:true,  value:true,  family:familyJSON},
+
+	TypeBigInt:      {singleton:true,  value:true,  family:familyBig},
+	TypeBigFloat:    {singleton:true,  value:true,  family:familyBig},
+	TypeBigRat:      {singleton:true,  value:true,  family:familyBig},
+
+	TypeBigInts:     {singleton:false, value:true,  family:familyBig},
+	TypeBigFloats:   {singleton:false, value:true,  family:familyBig},
+	TypeBigRats:     {singleton:false, value:true,  family:familyBig},
+
+	TypeRegexp:      {singleton:true,  value:true,  family:familyRegexp},
+	TypeRegexps:     {singleton:false, value:true,  family:familyRegexp},
+}
+
+// This type represents the kind of values accepted by an option. The kind of value defines how the values that appear
+// within the command line are interpreted, whatever the type of the option's value holder.
+// - KindDefault: values are interpreted according to the type of the value holder.
+// - KindSize: values are sizes, expressed in bytes, with optional SI or IEC units (ex: "4k", "512MiB" or "1.5GB").
+//   This kind applies to integers (and lists of integers).
+// - KindRanges: values are lists of integers and ranges of integers (ex: "0-3,8,10-11"). Each value is expanded into
+//   the list of integers it represents (ex: 0, 1, 2, 3, 8, 10, 11). This kind applies to lists of integers.
+// - KindPath: values are paths within the file system. Paths may be expanded and checked according to the option's
+//   attribute "PathFlags". This kind applies to strings, lists of strings and files (*os.File). Please note that
+//   values stored within files are always paths.
+// - KindJSON: values are JSON documents (ex: '{"status":["open"],"limit":10}'). The documents are decoded into the
+//   value holder, which may be a pointer to any type (ex: a pointer to a structure). The option can appear only once
+//   within the command line.
+// - KindGlob: values are glob patterns, as defined by the function path.Match (ex: "*.log"). The syntax of the patterns
+//   is checked. This kind applies to strings and lists of strings.
+// - KindRest: the option takes all the strings that follow it within the command line, verbatim, up to the option's
+//   terminator (ex: "--exec grep -n foo ;"), or up to the end of the command line if the option has no terminator.
+//   Strings that look like options (ex: "-n") are not interpreted. This kind applies to lists of strings, and the
+//   option can appear only once within the command line.
+
+type valueKind int
+
+const (
+	KindDefault valueKind = iota
+	KindSize
+	KindRanges
+	KindPath
+	KindJSON
+	KindGlob
+	KindRest
+)
+
+// This structure defines an option.
+// * The attribute "Short" represents the short name of the option. The short name is made of one, and only one, character.
+// * The attribute "Long" represents the long name of the option. The long name is made of one or more characters.
+// * The attribute "Holder" contains a pointer to the required data type. Please note that this pointer may point to an
+//   allocated variable or not. In the latter case, the variable will be allocated for you.
+// * The attribute "Choices" contains the list of values allowed for the option. An empty list means that all values
+//   are allowed. This attribute applies to strings and integers (and to lists of strings and integers) only.
+// * The attribute "IgnoreCase" indicates whether the comparison between the given value and the allowed values is case
+//   insensitive or not. If it is, then the value stored within the holder is the allowed value as written in "Choices".
+// * The attributes "Min" and "Max" contain the bounds of the values allowed for a numeric option (integers, unsigned
+//   integers or floats). A bound may be any GO numeric value (ex: 10, uint64(10) or 0.5). A nil bound means no limit.
+//   Bounds apply to each element of lists.
+// * The attributes "MinExclusive" and "MaxExclusive" indicate whether the bounds are excluded from the range of
+//   allowed values or not. By default, bounds are included.
+// * The attribute "Pattern" contains a regular expression that the values of a string option (or of a list of strings)
+//   must match. The regular expression is not anchored: use "^" and "$" to match the entire value.
+// * The attribute "PatternDescription" contains a human-readable description of the pattern (ex: "a lowercase
+//   identifier"). If specified, this description is used within the error messages instead of the regular expression.
+// * The attributes "MinLength" and "MaxLength" contain the minimum and the maximum numbers of characters of the
+//   values of a string option (or of a list of strings). The value 0 means no limit.
+// * The attribute "Validate" contains an optional function used to validate the values of the option. The function
+//   receives the converted value (for lists, each element is validated individually). If the function returns an
+//   error, then this error is wrapped into an error of type *OptionError.
+// * The attribute "Base" contains the base of the values of arbitrary precision integers (from 2 to 62) and floats (2,
+//   8, 10 or 16). The value 0 means base 10.
+// * The attribute "Precision" contains the precision (in bits) of arbitrary precision floats. The value 0 means 64 bits.
+// * The attribute "NumericSyntax" defines the syntax of integer values: strict decimal (SyntaxDecimal, the default) or
+//   GO integer literals (SyntaxGo), which allows prefixes such as "0x", "0o", "0b" and underscores between digits.
+// * The attribute "Kind" defines how the values are interpreted (ex: KindSize). By default, values are interpreted
+//   according to the type of the value holder.
+// * The attribute "MaxExpansion" contains the maximum number of integers stored within the value holder of an option
+//   which kind is KindRanges. The value 0 means the default limit (65536).
+// * The attribute "PathFlags" contains the requirements and the expansions that apply to the values of an option which
+//   kind is KindPath (ex: PathMustExist | PathMustBeFile | PathExpandHome).
+// * The attribute "JSONStrict" indicates whether the JSON documents given to an option which kind is KindJSON may contain
+//   fields that do not exist within the value holder or not. By default, unknown fields are ignored.
+// * The attribute "FileMarker" contains a prefix (ex: "@") which indicates that the value must be read from a file
+//   (ex: "--token @/path/to/token"). The value "@-" means that the value is read from the standard input. An empty
+//   prefix disables this mechanism.
+// * The attribute "FileOption" indicates whether the option has a paired "-file" option (ex: "--token-file") or not.
+//   The value of the paired option is the path to the file that contains the value of the option ("-" for the
+//   standard input). Paired options require a long name.
+// * The attribute "MaxFileSize" contains the maximum size (in bytes) of a value read from a file. The value 0 means
+//   the default limit (1 MiB). Please note that trailing newlines are removed from values read from files.
+// * The attribute "Encoding" defines how the value of an option which value holder is a byte slice (*[]byte) is
+//   decoded (ex: EncodingHex or EncodingBase64). By default (EncodingNone), the byte slice is a list of integers.
+// * The attribute "ByteLength" contains the exact number of bytes of the decoded value of an option that has an
+//   encoding. The value 0 means any length.
+// * The attribute "StrictBool" indicates whether the values attached to a flag (ex: "--cache=false") are limited to the
+//   words "true" and "false" or not. By default, the words "yes", "no", "on", "off", "1" and "0" are also accepted.
+// * The attribute "Required" indicates whether the option must appear within the command line or not. If required
+//   options are missing, then the parsing fails with an error of type *MissingOptionsError. Please note that a default
+//   value does not satisfy the requirement.
+// * The attributes "MinOccurrences" and "MaxOccurrences" contain the minimum and the maximum numbers of times the option
+//   may appear within the command line. The value 0 means no limit. These limits are checked once the command line has
+//   been parsed. Please note that options that don't take lists of values can appear only once.
+// * The attribute "Default" contains the default value of the option. The default value is stored within the value
+//   holder before the command line is parsed. It may be a string (or a list of strings), interpreted as if it was
+//   given within the command line, or a value of the type of the variable pointed by the value holder (ex: 10 or
+//   []int{1, 2}). For lists, the values given within the command line replace the default list. Please note that the
+//   default value does not set the option (see IsSet).
+// * The attribute "Secret" indicates whether the values of the option are secret (ex: passwords) or not. The values of
+//   secret options are replaced by a mask within the expanded command line returned by Parse, within the error
+//   messages and within the debug dumps. The value holder still receives the real value.
+// * The attribute "Terminator" contains the string that ends the values of a rest-of-line option (see KindRest). For
+//   example, the terminator of the option "--exec" of find(1) is ";". If the terminator is empty, then the option takes
+//   all the strings up to the end of the command line. Otherwise, the terminator must appear within the command line.
+// * The attribute "set" indicates whether the option is set or not.
+//   The value true indicates that the option is set.
+//   The value false indicates that the option is not set.
+// * The attribute "occurrences" contains the number of times the option appears within the command line.
+// * The attribute "defaulted" indicates whether the value holder contains the default value or not.
+// * The attribute "values" contains the values of the option, as they appear within the command line.
+// * The attribute "path" contains the path of the file to open, for options which value holders store files (*os.File).
+//   Files are opened once the whole command line has been parsed and checked.
+
+type Option struct {
+	Short string        // The option's short name.
+	Long string         // The option's long name.
+	Holder interface{}  // pointer to the option's value holder.
+	Choices []string    // The list of allowed values (empty means all values).
+	IgnoreCase bool     // The flag that specifies whether the allowed values are case insensitive or not.
+	Min interface{}     // The lower bound of the allowed values (nil means no lower bound).
+	Max interface{}     // The upper bound of the allowed values (nil means no upper bound).
+	MinExclusive bool   // The flag that specifies whether the lower bound is excluded or not.
+	MaxExclusive bool   // The flag that specifies whether the upper bound is excluded or not.
+	Pattern string      // The regular expression that string values must match (empty means all values).
+	PatternDescription string // The human-readable description of the pattern.
+	MinLength int       // The minimum number of characters of string values.
+	MaxLength int       // The maximum number of characters of string values (0 means no limit).
+	Validate func(interface{}) error // The function used to validate the values.
+	Base int            // The base of arbitrary precision integers and floats (0 means base 10).
+	Precision uint      // The precision of arbitrary precision floats (0 means 64 bits).
+	NumericSyntax numericSyntax // The syntax of integer values (strict decimal by default).
+	Kind valueKind      // The kind of values accepted by the option.
+	MaxExpansion int    // The maximum number of integers produced by ranges (0 means the default limit).
+	PathFlags pathFlag  // The requirements and the expansions that apply to paths.
+	JSONStrict bool     // The flag that specifies whether unknown JSON fields are rejected or not.
+	FileMarker string   // The prefix of values read from files (empty means disabled).
+	FileOption bool     // The flag that specifies whether the option has a paired "-file" option or not.
+	MaxFileSize int64   // The maximum size of values read from files (0 means the default limit).
+	Encoding byteEncoding // The encoding of byte slices (EncodingNone means a list of integers).
+	ByteLength int      // The exact number of decoded bytes (0 means any length).
+	StrictBool bool     // The flag that specifies whether the values of flags are limited to "true" and "false" or not.
+	Required bool       // The flag that specifies whether the option must appear within the command line or not.
+	MinOccurrences int  // The minimum number of occurrences of the option.
+	MaxOccurrences int  // The maximum number of occurrences of the option (0 means no limit).
+	Default interface{} // The default value (nil means no default value).
+	Secret bool         // The flag that specifies whether the values are secret or not.
+	Terminator string   // The string that ends the values of a rest-of-line option (empty means the end of the line).
+	set bool            // The flag that specifies whether the option is set or not.
+	occurrences int     // The number of times the option appears within the command line.
+	defaulted bool      // The flag that specifies whether the value holder contains the default value or not.
+	values []string     // The values of the option, as they appear within the command line.
+	path string         // The path of the file to open, for options which value holders store files.
+}
+
+// Test whether an option is set or not (that is, whether it appears within the command line or not).
+// If the option is set, then the function returns the value true.
+// Otherwise, it returns the value false.
+// Please note that the options returned by Spec.Lookup reflect the last parsed command line.
+
+func (o *Option) IsSet() bool {
+	return o.set
+}
+
+// Test whether an option is set and, for flags, whether its value is true. A flag given the value false within the
+// command line (ex: "--tls=false") is set, but it does not count as present for the constraints between options
+// (required options, groups and implications).
+
+func (o *Option) isEnabled() bool {
+	if ! o.IsSet() { return false }
+	if t, _ := o.getType(); TypeBool != t { return true }
+	v := reflect.ValueOf(o.Holder).Elem()
+	if o.isIndirect() {
+		if v.IsNil() { return false }
+		v = v.Elem()
+	}
+	return v.Bool()
+}
+
+// Return the number of times an option appears within the command line.
+// Please note that the options returned by Spec.Lookup reflect the last parsed command line.
+
+func (o *Option) Occurrences() int {
+	return o.occurrences
+}
+
+// Declare an option as being set.
+
+func (o *Option) setIt() {
+	o.set = true
+	o.occurrences++
+}
+
+// Add a value to an option.
+// Please note that the parameter inValue may be a string or a boolean. For flags, the value may be a boolean or a word
+// that represents a boolean value (ex: "yes" or "off").
+
+func (o *Option) addValue(inValue interface{}) error {
+
+	if o.defaulted {
+		// The values given within the command line replace the default value.
+		o.clearDefault()
+	}
+
+	// Record the value, as it appears within the command line.
+	if s, ok := inValue.(string); ok {
+		o.values = append(o.values, s)
+	} else {
+		o.values = append(o.values, fmt.Sprint(inValue))
+	}
+
+	if o.isIndirect() {
+		// The value is stored within the variable pointed by the pointer (which is allocated if necessary).
+		element := *o
+		element.Holder = o.getIndirectHolder()
+		err := element.addValue(inValue)
+		o.path = element.path
+		return err
+	}
+
+	typeOption, _ := o.getType()
+
+	if TypeBool == typeOption {
+		var v bool
+		switch value := inValue.(type) {
+			case bool:
+				v = value
+			case string:
+				b, err := o.parseBool(value)
+				if nil != err { return err }
+				v = b
+			default:
+				return errors.New(errorInvalidValueBoolExpected)
+		}
+		p, _ := o.Holder.(*bool)
+		if nil == p {
+			o.Holder = new(bool)
+			p, _ = o.Holder.(*bool)
+		}
+		*p = v
+		return o.checkValidator(fmt.Sprintf(`%t`, v))
+	}
+
+	v, ok := inValue.(string);
+	if ! ok {
+		return errors.New(errorInvalidValueStringExpected)
+	}
+
+	if KindRanges == o.Kind {
+		return o.addRanges(v)
+	}
+	if KindJSON == o.Kind {
+		if err := o.decodeJSON(v); nil != err { return err }
+		return o.checkValidator(v)
+	}
+
+	v, err := o.checkChoice(v)
+	if nil != err { return err }
+	if err := o.checkPattern(v); nil != err { return err }
+	if KindGlob == o.Kind {
+		if err := o.checkGlob(v); nil != err { return err }
+	}
+	if KindPath == o.Kind || TypeFile == typeOption {
+		if v, err = o.checkPath(v); nil != err { return err }
+	}
+
+	switch typeOption {
+		case TypeString:
+			p, _ := o.Holder.(*string)
+			if nil == p {
+				o.Holder = new(string)
+				p, _ = o.Holder.(*string)
+			}
+			*p = v
+		case TypeInteger:
+			// Be careful, the variable "p" is a copy of the variable "o.Holder".
+			// Affecting a value to "p" does not modify the value of "o.Holder".
+			p, _ := o.Holder.(*int)
+
+			// Be careful, "o.Holder" is an interface.
+			// We cannot directly compare an interface's against nil.
+			if nil == p {
+				o.Holder = new(int)
+				p, _ = o.Holder.(*int) // The value of "o.Holder" changed!
+			}
+			v, err := o.parseInt(v, 0)
+			if nil != err { return err }
+			*p = int(v)
+		case TypeInteger8:
+			p, _ := o.Holder.(*int8)
+			if nil == p {
+				o.Holder = new(int8)
+				p, _ = o.Holder.(*int8)
+			}
+			v, err := o.parseInt(v, 8)
+			if nil != err { return err }
+			*p = int8(v)
+		case TypeInteger16:
+			p, _ := o.Holder.(*int16)
+			if nil == p {
+				o.Holder = new(int16)
+				p, _ = o.Holder.(*int16)
+			}
+			v, err := o.parseInt(v, 16)
+			if nil != err { return err }
+			*p = int16(v)
+		case TypeInteger32:
+			p, _ := o.Holder.(*int32)
+			if nil == p {
+				o.Holder = new(int32)
+				p, _ = o.Holder.(*int32)
+			}
+			v, err := o.parseInt(v, 32)
+			if nil != err { return err }
+			*p = int32(v)
+		case TypeInteger64:
+			p, _ := o.Holder.(*int64)
+			if nil == p {
+				o.Holder = new(int64)
+				p, _ = o.Holder.(*int64)
+			}
+			v, err := o.parseInt(v, 64)
+			if nil != err { return err }
+			*p = v
+		case TypeUInteger:
+			p, _ := o.Holder.(*uint)
+			if nil == p {
+				o.Holder = new(uint)
+				p, _ = o.Holder.(*uint) // The value of "o.Holder" changed!
+			}
+			v, err := o.parseUint(v, 0)
+			if nil != err { return err }
+			*p = uint(v)
+		case TypeUInteger8:
+			p, _ := o.Holder.(*uint8)
+			if nil == p {
+				o.Holder = new(uint8)
+				p, _ = o.Holder.(*uint8)
+			}
+			v, err := o.parseUint(v, 8)
+			if nil != err { return err }
+			*p = uint8(v)
+		case TypeUInteger16:
+			p, _ := o.Holder.(*uint16)
+			if nil == p {
+				o.Holder = new(uint16)
+				p, _ = o.Holder.(*uint16)
+			}
+			v, err := o.parseUint(v, 16)
+			if nil != err { return err }
+			*p = uint16(v)
+		case TypeUInteger32:
+			p, _ := o.Holder.(*uint32)
+			if nil == p {
+				o.Holder = new(uint32)
+				p, _ = o.Holder.(*uint32)
+			}
+			v, err := o.parseUint(v, 32)
+			if nil != err { return err }
+			*p = uint32(v)
+		case TypeUInteger64:
+			p, _ := o.Holder.(*uint64)
+			if nil == p {
+				o.Holder = new(uint64)
+				p, _ = o.Holder.(*uint64)
+			}
+			v, err := o.parseUint(v, 64)
+			if nil != err { return err }
+			*p = v
+		case TypeFloat32:
+			p, _ := o.Holder.(*float32)
+			if nil == p {
+				o.Holder = new(float32)
+				p, _ = o.Holder.(*float32)
+			}
+			v, err := o.parseFloat(v, 32)
+			if nil != err { return err }
+			*p = float32(v)
+		case TypeFloat64:
+			p, _ := o.Holder.(*float64)
+			if nil == p {
+				o.Holder = new(float64)
+				p, _ = o.Holder.(*float64)
+			}
+			v, err := o.parseFloat(v, 64)
+			if nil != err { return err }
+			*p = v
+		case TypeStrings:
+			p, _ := o.Holder.(*[]string)
+			if nil == p { *p = make([]string, 0) }
+			*p = append(*p, v)
+
+
+		case TypeIntegers:
+			v, err := o.parseInt(v, 0)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]int)
+			if nil == p { *p = make([]int, 0) }
+			*p = append(*p, int(v))
+		case TypeIntegers8:
+			v, err := o.parseInt(v, 8)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]int8)
+			if nil == p { *p = make([]int8, 0) }
+			*p = append(*p, int8(v))
+		case TypeIntegers16:
+			v, err := o.parseInt(v, 16)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]int16)
+			if nil == p { *p = make([]int16, 0) }
+			*p = append(*p, int16(v))
+		case TypeIntegers32:
+			v, err := o.parseInt(v, 32)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]int32)
+			if nil == p { *p = make([]int32, 0) }
+			*p = append(*p, int32(v))
+		case TypeIntegers64:
+			v, err := o.parseInt(v, 64)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]int64)
+			if nil == p { *p = make([]int64, 0) }
+			*p = append(*p, v)
+		case TypeUIntegers:
+			v, err := o.parseUint(v, 0)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]uint)
+			if nil == p { *p = make([]uint, 0) }
+			*p = append(*p, uint(v))
+		case TypeUIntegers8:
+			v, err := o.parseUint(v, 8)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]uint8)
+			if nil == p { *p = make([]uint8, 0) }
+			*p = append(*p, uint8(v))
+		case TypeUIntegers16:
+			v, err := o.parseUint(v, 16)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]uint16)
+			if nil == p { *p = make([]uint16, 0) }
+			*p = append(*p, uint16(v))
+		case TypeUIntegers32:
+			v, err := o.parseUint(v, 32)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]uint32)
+			if nil == p { *p = make([]uint32, 0) }
+			*p = append(*p, uint32(v))
+		case TypeUIntegers64:
+			v, err := o.parseUint(v, 64)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]uint64)
+			if nil == p { *p = make([]uint64, 0) }
+			*p = append(*p, v)
+		case TypeFloats32:
+			v, err := o.parseFloat(v, 32)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]float32)
+			if nil == p { *p = make([]float32, 0) }
+			*p = append(*p, float32(v))
+		case TypeFloats64:
+			v, err := o.parseFloat(v, 64)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]float64)
+			if nil == p { *p = make([]float64, 0) }
+			*p = append(*p, v)
+
+		case TypeIP:
+			v, err := o.parseIP(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*net.IP)
+			if nil == p {
+				o.Holder = new(net.IP)
+				p, _ = o.Holder.(*net.IP)
+			}
+			*p = v
+		case TypeIPNet:
+			v, err := o.parseIPNet(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*net.IPNet)
+			if nil == p {
+				o.Holder = new(net.IPNet)
+				p, _ = o.Holder.(*net.IPNet)
+			}
+			*p = *v
+		case TypePrefix:
+			v, err := o.parsePrefix(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*netip.Prefix)
+			if nil == p {
+				o.Holder = new(netip.Prefix)
+				p, _ = o.Holder.(*netip.Prefix)
+			}
+			*p = v
+		case TypeAddrPort:
+			v, err := o.parseAddrPort(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*netip.AddrPort)
+			if nil == p {
+				o.Holder = new(netip.AddrPort)
+				p, _ = o.Holder.(*netip.AddrPort)
+			}
+			*p = v
+		case TypeURL:
+			v, err := o.parseURL(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*url.URL)
+			if nil == p {
+				o.Holder = new(url.URL)
+				p, _ = o.Holder.(*url.URL)
+			}
+			*p = *v
+		case TypeIPs:
+			v, err := o.parseIP(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]net.IP)
+			*p = append(*p, v)
+		case TypeIPNets:
+			v, err := o.parseIPNet(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]net.IPNet)
+			*p = append(*p, *v)
+		case TypePrefixes:
+			v, err := o.parsePrefix(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]netip.Prefix)
+			*p = append(*p, v)
+		case TypeAddrPorts:
+			v, err := o.parseAddrPort(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]netip.AddrPort)
+			*p = append(*p, v)
+		case TypeURLs:
+			v, err := o.parseURL(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]url.URL)
+			*p = append(*p, *v)
+
+		case TypeFile:
+			// The file is opened once the whole command line has been parsed and checked (see the function
+			// openFiles). The validator is applied to the opened file.
+			o.path = v
+			return nil
+
+		case TypeBytes:
+			v, err := o.decodeBytes(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]byte)
+			if nil == p {
+				o.Holder = new([]byte)
+				p, _ = o.Holder.(*[]byte)
+			}
+			*p = v
+
+		case TypeBigInt:
+			v, err := o.parseBigInt(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*big.Int)
+			if nil == p {
+				o.Holder = new(big.Int)
+				p, _ = o.Holder.(*big.Int)
+			}
+			p.Set(v)
+		case TypeBigFloat:
+			v, err := o.parseBigFloat(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*big.Float)
+			if nil == p {
+				o.Holder = new(big.Float)
+				p, _ = o.Holder.(*big.Float)
+			}
+			p.SetPrec(v.Prec()).Set(v)
+		case TypeBigRat:
+			v, err := o.parseBigRat(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*big.Rat)
+			if nil == p {
+				o.Holder = new(big.Rat)
+				p, _ = o.Holder.(*big.Rat)
+			}
+			p.Set(v)
+		case TypeBigInts:
+			v, err := o.parseBigInt(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]*big.Int)
+			*p = append(*p, v)
+		case TypeBigFloats:
+			v, err := o.parseBigFloat(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]*big.Float)
+			*p = append(*p, v)
+		case TypeBigRats:
+			v, err := o.parseBigRat(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]*big.Rat)
+			*p = append(*p, v)
+
+		case TypeRegexp:
+			v, err := o.parseRegexp(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*regexp.Regexp)
+			if nil == p {
+				o.Holder = new(regexp.Regexp)
+				p, _ = o.Holder.(*regexp.Regexp)
+			}
+			*p = *v
+		case TypeRegexps:
+			v, err := o.parseRegexp(v)
+			if nil != err { return err }
+			p, _ := o.Holder.(*[]*regexp.Regexp)
+			*p = append(*p, v)
+	}
+	return o.checkValidator(v)
+}
+
+// Test whether an option can appear only once within the command line or not.
+
+func (o *Option) isSingleton() bool {
+	t, _ := o.getType();
+	return typesConstraints[t].singleton
+}
+
+// Test whether an option requires a value or not.
+
+func (o *Option) requireValue() bool {
+	t, _ := o.getType();
+	return typesConstraints[t].value
+}
+
+// Return the long name associated to an option, if it exists.
+
+func (o *Option) getLong() (string, bool) {
+	if "" != o.Long { return o.Long, true }
+	return "", false
+}
+
+// Return the short name associated to an option, if it exists.
+
+func (o *Option) getShort() (string, bool) {
+	if "" != o.Short { return o.Short, true }
+	return "", false
+}
+
+// Return the name used to designate an option within messages.
+// If the option has a long name, then the function returns the long name. Otherwise, it returns the short name.
+
+func (o *Option) getName() string {
+	if "" != o.Long { return o.Long }
+	return o.Short
+}
+
+// Return the family of the values stored by the option's value holder.
+
+func (o *Option) getFamily() typeFamily {
+	t, _ := o.getType();
+	return typesConstraints[t].family
+}
+
+// Initialise an option. The initialisation consists of the actions listed below:
+// - Checks that at least one name is specified (the short one or the long one).
+// - Checks that the type of the variable used to store the option's value is valid.
+// - Checks that the kind of values is compatible with the type of the variable.
+// - Checks that the numeric syntax is compatible with the type of the variable.
+// - Checks that the list of allowed values (if any) is compatible with the type of the variable.
+// - Checks that the range of allowed values (if any) is compatible with the type of the variable.
+// - Checks that the pattern and the lengths of the values (if any) are compatible with the type of the variable.
+// - Checks that values can be read from files (if requested).
+// - Checks that the encoding (if any) is compatible with the type of the variable.
+// - Checks that the strict boolean syntax (if requested) is compatible with the type of the variable.
+// - Checks that the strict JSON decoding (if requested) is compatible with the kind of values.
+// - Checks that the base and the precision (if any) are compatible with the type of the variable.
+// - Checks that the limits on the number of occurrences (if any) are valid.
+// - Initialises the value of the option, in the case of an option that does not take values.
+// - Initialises the state (set or unset) of the option. the state is initialised to the value false (unset).
+// - Initialises the pointer pointed by a pointer-to-pointer value holder (ex: **int) to nil.
+// - Stores the default value of the option (if any) within the value holder.
+
+func (o *Option) init() error {
+
+	if "" == o.Short && "" == o.Long {
+		return errors.New(errorInvalidOptionSpecificationNoName)
+	}
+	if "" != o.Short {
+		if len(o.Short) > 1 {
+			return errors.New(fmt.Sprintf(errorShortNameTooLong, o.Short))
+		}
+		if ok, _ := isOptionShort(o.Short); ! ok {
+			return errors.New(fmt.Sprintf(errorShortNameUnexpectedCharacter, o.Short))
+		}
+	}
+	if "" != o.Long {
+		if ok, _ := isOptionLong(o.Long); ! ok {
+			return errors.New(fmt.Sprintf(errorLongNameUnexpectedCharacter, o.Long))
+		}
+	}
+	if _, err := o.getType(); nil != err {
+		return errors.New(errorInvalidOptionSpecificationUnexpectedHolderType)
+	}
+	if err := o.initKind(); nil != err {
+		return err
+	}
+	if err := o.initNumericSyntax(); nil != err {
+		return err
+	}
+	if err := o.initChoices(); nil != err {
+		return err
+	}
+	if err := o.initRange(); nil != err {
+		return err
+	}
+	if err := o.initPattern(); nil != err {
+		return err
+	}
+	if err := o.initSource(); nil != err {
+		return err
+	}
+	if err := o.initBytes(); nil != err {
+		return err
+	}
+	if err := o.initBool(); nil != err {
+		return err
+	}
+	if err := o.initJSON(); nil != err {
+		return err
+	}
+	if err := o.initBig(); nil != err {
+		return err
+	}
+	if err := o.initOccurrences(); nil != err {
+		return err
+	}
+
+	o.set = false
+	o.occurrences = 0
+	o.defaulted = false
+	o.values = nil
+	o.path = ""
+	if o.isIndirect() {
+		v := reflect.ValueOf(o.Holder).Elem()
+		v.Set(reflect.Zero(v.Type()))
+	} else if t, _ := o.getType(); TypeBool == t {
+		p, _ := o.Holder.(*bool)
+		*p = false
+	}
+
+	return o.applyDefault()
+}
+
+// Check that the kind of values accepted by an option is compatible with the type of the option's value holder.
+
+func (o *Option) initKind() error {
+	if "" != o.Terminator && KindRest != o.Kind {
+		return errors.New(errorTerminatorUnexpectedKind)
+	}
+	switch o.Kind {
+		case KindDefault:
+			if familyFile == o.getFamily() { return o.initPath() }
+			if 0 != o.PathFlags { return errors.New(errorPathFlagsUnexpectedKind) }
+			return nil
+		case KindSize:
+			if f := o.getFamily(); familySigned == f || familyUnsigned == f { return nil }
+			return errors.New(errorSizeUnexpectedHolderType)
+		case KindRanges:
+			if f := o.getFamily(); ! o.isSingleton() && (familySigned == f || familyUnsigned == f) { return nil }
+			return errors.New(errorRangesUnexpectedHolderType)
+		case KindPath:
+			return o.initPath()
+		case KindJSON:
+			return nil
+		case KindGlob:
+			return o.initGlob()
+		case KindRest:
+			return o.initRest()
+	}
+	return errors.New(fmt.Sprintf(errorInvalidKind, o.Kind))
+}
+
+// Return the type of the variable used to store the option's value.
+// This type defines the constraints that apply to the option's value.
+
+func (o Option) getType() (typeOption, error) {
+	if o.isIndirect() {
+		// The type is the type of the pointed pointer (ex: *int for **int). Only one level of indirection is allowed.
+		o.Holder = reflect.New(reflect.TypeOf(o.Holder).Elem().Elem()).Interface()
+		if o.isIndirect() { return TypeUnexpected, errors.New(errorUnexpectedType) }
+	}
+
+	// JSON documents can be decoded into any type.
+	if KindJSON == o.Kind {
+		if v := reflect.ValueOf(o.Holder); reflect.Ptr == v.Kind() && ! v.IsNil() { return TypeJSON, nil }
+		return TypeUnexpected, errors.New(errorUnexpectedType)
+	}
+
+	if _, ok := o.Holder.(*bool); ok { return TypeBool, nil }
+
+	// Single value
+	if _, ok := o.Holder.(*string);    ok { return TypeString,      nil }
+	if _, ok := o.Holder.(*int);       ok { return TypeInteger,     nil }
+	if _, ok := o.Holder.(*int8);      ok { return TypeInteger8,    nil }
+	if _, ok := o.Holder.(*int16);     ok { return TypeInteger16,   nil }
+	if _, ok := o.Holder.(*int32);     ok { return TypeInteger32,   nil }
+	if _, ok := o.Holder.(*int64);     ok { return TypeInteger64,   nil }
+	if _, ok := o.Holder.(*float32);   ok { return TypeFloat32,     nil }
+	if _, ok := o.Holder.(*float64);   ok { return TypeFloat64,     nil }
+	if _, ok := o.Holder.(*uint);      ok { return TypeUInteger,    nil }
+	if _, ok := o.Holder.(*uint8);     ok { return TypeUInteger8,   nil }
+	if _, ok := o.Holder.(*uint16);    ok { return TypeUInteger16,  nil }
+	if _, ok := o.Holder.(*uint32);    ok { return TypeUInteger32,  nil }
+	if _, ok := o.Holder.(*uint64);    ok { return TypeUInteger64,  nil }
+
+	// Multiple values
+	if _, ok := o.Holder.(*[]string);  ok { return TypeStrings,     nil }
+	if _, ok := o.Holder.(*[]int);     ok { return TypeIntegers,    nil }
+	if _, ok := o.Holder.(*[]int8);    ok { return TypeIntegers8,   nil }
+	if _, ok := o.Holder.(*[]int16);   ok { return TypeIntegers16,  nil }
+	if _, ok := o.Holder.(*[]int32);   ok { return TypeIntegers32,  nil }
+	if _, ok := o.Holder.(*[]int64);   ok { return TypeIntegers64,  nil }
+	if _, ok := o.Holder.(*[]float32); ok { return TypeFloats32,    nil }
+	if _, ok := o.Holder.(*[]float64); ok { return TypeFloats64,    nil }
+	if _, ok := o.Holder.(*[]uint);    ok { return TypeUIntegers,   nil }
+	if _, ok := o.Holder.(*[]uint8);   ok && EncodingNone != o.Encoding { return TypeBytes, nil }
+	if _, ok := o.Holder.(*[]uint8);   ok { return TypeUIntegers8,  nil }
+	if _, ok := o.Holder.(*[]uint16);  ok { return TypeUIntegers16, nil }
+	if _, ok := o.Holder.(*[]uint32);  ok { return TypeUIntegers32, nil }
+	if _, ok := o.Holder.(*[]uint64);  ok { return TypeUIntegers64, nil }
+
+	// Network values
+	if _, ok := o.Holder.(*net.IP);          ok { return TypeIP,        nil }
+	if _, ok := o.Holder.(*net.IPNet);       ok { return TypeIPNet,     nil }
+	if _, ok := o.Holder.(*netip.Prefix);    ok { return TypePrefix,    nil }
+	if _, ok := o.Holder.(*netip.AddrPort);  ok { return TypeAddrPort,  nil }
+	if _, ok := o.Holder.(*url.URL);         ok { return TypeURL,       nil }
+	if _, ok := o.Holder.(*[]net.IP);        ok { return TypeIPs,       nil }
+	if _, ok := o.Holder.(*[]net.IPNet);     ok { return TypeIPNets,    nil }
+	if _, ok := o.Holder.(*[]netip.Prefix);  ok { return TypePrefixes,  nil }
+	if _, ok := o.Holder.(*[]netip.AddrPort); ok { return TypeAddrPorts, nil }
+	if _, ok := o.Holder.(*[]url.URL);       ok { return TypeURLs,      nil }
+
+	// Files
+	if _, ok := o.Holder.(**os.File);        ok { return TypeFile,      nil }
+
+	// Arbitrary precision numbers
+	if _, ok := o.Holder.(*big.Int);         ok { return TypeBigInt,    nil }
+	if _, ok := o.Holder.(*big.Float);       ok { return TypeBigFloat,  nil }
+	if _, ok := o.Holder.(*big.Rat);         ok { return TypeBigRat,    nil }
+	if _, ok := o.Holder.(*[]*big.Int);      ok { return TypeBigInts,   nil }
+	if _, ok := o.Holder.(*[]*big.Float);    ok { return TypeBigFloats, nil }
+	if _, ok := o.Holder.(*[]*big.Rat);      ok { return TypeBigRats,   nil }
+
+	// Regular expressions
+	if _, ok := o.Holder.(*regexp.Regexp);   ok { return TypeRegexp,    nil }
+	if _, ok := o.Holder.(*[]*regexp.Regexp); ok { return TypeRegexps,  nil }
+
+	return TypeUnexpected, errors.New(errorUnexpectedType)
+}
+
+
+// Test whether the value holder of an option is a pointer to a pointer (ex: **int or **[]string).
+// Please note that **os.File is not such a value holder: it is the value holder for files.
+
+func (o Option) isIndirect() bool {
+	if _, ok := o.Holder.(**os.File); ok { return false }
+	t := reflect.TypeOf(o.Holder)
+	return nil != t && reflect.Ptr == t.Kind() && reflect.Ptr == t.Elem().Kind()
+}
+
+// Return the pointer pointed by a pointer-to-pointer value holder (ex: the *int pointed by a **int).
+// If the pointer is nil, then a variable is allocated and the pointer is set to the address of this variable.
+
+func (o *Option) getIndirectHolder() interface{} {
+	v := reflect.ValueOf(o.Holder).Elem()
+	if v.IsNil() {
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+	return v.Interface()
+}
@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// This type represents the requirements and the expansions that apply to the values of an option which kind is
+// KindPath. Flags can be combined (ex: PathMustExist | PathMustBeFile).
+// - PathMustExist: the path must exist.
+// - PathMustBeFile: the path must be a regular file (implies PathMustExist).
+// - PathMustBeDir: the path must be a directory (implies PathMustExist).
+// - PathMustBeWritable: the path must be writable. If the path does not exist, then its parent directory must be
+//   writable. For files (*os.File), this flag means that the file is opened for writing (it is created or truncated).
+//   Please note that files are opened only once the whole command line has been parsed and checked (see the function
+//   openFiles). Thus, a command line that is not valid does not modify the file system.
+// - PathParentMustExist: the parent directory of the path must exist.
+// - PathExpandHome: a leading "~" is replaced by the home directory of the current user (ex: "~/.config").
+// - PathAbsolute: relative paths are converted into absolute paths, relatively to the current working directory.
+//
+// Please note that, for files (*os.File), the value "-" represents the standard input (or the standard output if the
+// file is opened for writing). No requirement applies to this value.
+
+type pathFlag int
+
+const (
+	PathMustExist pathFlag = 1 << iota
+	PathMustBeFile
+	PathMustBeDir
+	PathMustBeWritable
+	PathParentMustExist
+	PathExpandHome
+	PathAbsolute
+)
+
+// The value that represents the standard input (or the standard output) for files.
+
+const pathStandardStream = `-`
+
+// Check that the kind of values KindPath is compatible with the type of the option's value holder, and that the
+// option's path flags are consistent.
+
+func (o *Option) initPath() error {
+	switch o.getFamily() {
+		case familyString, familyFile:
+		default:
+			return errors.New(errorPathUnexpectedHolderType)
+	}
+	if 0 != o.PathFlags & PathMustBeFile && 0 != o.PathFlags & PathMustBeDir {
+		return errors.New(errorInvalidPathFlags)
+	}
+	return nil
+}
+
+// Test whether a path is writable or not.
+// If the path does not exist, then the function tests whether a file can be created within the parent directory.
+// Please note that the test does not modify the file system (see the function canWrite).
+
+func isWritable(inPath string) bool {
+	if _, err := os.Stat(inPath); nil != err {
+		if ! os.IsNotExist(err) { return false }
+		return isWritable(filepath.Dir(inPath))
+	}
+	return canWrite(inPath)
+}
+
+// Expand a path and check that it meets the requirements defined by the option's path flags.
+// The function returns the expanded path.
+
+func (o *Option) checkPath(inValue string) (string, error) {
+	if familyFile == o.getFamily() && pathStandardStream == inValue { return inValue, nil }
+
+	path := inValue
+	if 0 != o.PathFlags & PathExpandHome && (`~` == path || strings.HasPrefix(path, `~/`)) {
+		home, err := os.UserHomeDir()
+		if nil != err {
+			return "", errors.New(fmt.Sprintf(errorInvalidValuePathExpansion, o.displayValue(inValue), o.getName(), o.displayError(err)))
+		}
+		path = filepath.Join(home, path[1:])
+	}
+	if 0 != o.PathFlags & PathAbsolute {
+		abs, err := filepath.Abs(path)
+		if nil != err {
+			return "", errors.New(fmt.Sprintf(errorInvalidValuePathExpansion, o.displayValue(inValue), o.getName(), o.displayError(err)))
+		}
+		path = abs
+	}
+
+	mustExist := 0 != o.PathFlags & (PathMustExist | PathMustBeFile | PathMustBeDir)
+	info, err := os.Stat(path)
+	if nil != err && mustExist {
+		return "", errors.New(fmt.Sprintf(errorInvalidValuePathNotFound, o.displayValue(inValue), o.getName()))
+	}
+	if 0 != o.PathFlags & PathMustBeFile && ! info.Mode().IsRegular() {
+		return "", errors.New(fmt.Sprintf(errorInvalidValuePathNotAFile, o.displayValue(inValue), o.getName()))
+	}
+	if 0 != o.PathFlags & PathMustBeDir && ! info.IsDir() {
+		return "", errors.New(fmt.Sprintf(errorInvalidValuePathNotADirectory, o.displayValue(inValue), o.getName()))
+	}
+	if 0 != o.PathFlags & PathParentMustExist {
+		if parent, err := os.Stat(filepath.Dir(path)); nil != err || ! parent.IsDir() {
+			return "", errors.New(fmt.Sprintf(errorInvalidValuePathNoParent, o.displayValue(inValue), o.getName()))
+		}
+	}
+	if 0 != o.PathFlags & PathMustBeWritable && ! isWritable(path) {
+		return "", errors.New(fmt.Sprintf(errorInvalidValuePathNotWritable, o.displayValue(inValue), o.getName()))
+	}
+	return path, nil
+}
+
+// Test whether the file of an option which value holder stores a file must be opened for writing or not.
+
+func (o *Option) isWriteFile() bool {
+	return 0 != o.PathFlags & PathMustBeWritable
+}
+
+// Open the file identified by a given path.
+// If the option's path flags contain PathMustBeWritable, then the file is opened for writing (it is created if it
+// does not exist). Please note that the file is not truncated: it is truncated by the function openFiles, once all
+// the files have been opened. Otherwise, the file is opened for reading.
+// The path "-" represents the standard input (or the standard output if the file is opened for writing).
+// Please note that the caller is responsible for closing the file.
+
+func (o *Option) openFile(inPath string) (*os.File, error) {
+	write := o.isWriteFile()
+	if pathStandardStream == inPath {
+		if write { return os.Stdout, nil }
+		return os.Stdin, nil
+	}
+
+	var f *os.File
+	var err error
+	if write {
+		f, err = os.OpenFile(inPath, os.O_WRONLY | os.O_CREATE, 0666)
+	} else {
+		f, err = os.Open(inPath)
+	}
+	if nil != err {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueFileOpen, o.displayValue(inPath), o.getName(), o.displayError(err)))
+	}
+	return f, nil
+}
+
+// Return the variable used to store the file of an option which value holder stores a file (the variable is allocated
+// if necessary).
+
+func (o *Option) getFileHolder() **os.File {
+	if o.isIndirect() {
+		p, _ := o.getIndirectHolder().(**os.File)
+		return p
+	}
+	p, _ := o.Holder.(**os.File)
+	if nil == p {
+		o.Holder = new(*os.File)
+		p, _ = o.Holder.(**os.File)
+	}
+	return p
+}
+
+// Open the files of all the options which value holders store files (*os.File), and store them within the options'
+// value holders. This function is called once the whole command line has been parsed and checked, so that a command
+// line that is not valid neither creates nor truncates files. The options' validators (if any) are applied to the
+// opened files. The files opened for writing are truncated once all the files have been opened and validated.
+// If a file cannot be opened (or if it is rejected by a validator), then all the files opened by the function are
+// closed, the files created by the function are removed, and the function returns an error.
+
+func openFiles(inSpec Spec) error {
+	opened := make([]*Option, 0)
+	created := make([]string, 0)
+	fail := func(inErr error) error {
+		closeFiles(opened)
+		for _, path := range created { os.Remove(path) }
+		return inErr
+	}
+
+	for i := range inSpec {
+		o := &inSpec[i]
+		if t, _ := o.getType(); TypeFile != t || "" == o.path { continue }
+
+		if o.isWriteFile() && pathStandardStream != o.path {
+			if _, err := os.Stat(o.path); os.IsNotExist(err) { created = append(created, o.path) }
+		}
+		f, err := o.openFile(o.path)
+		if nil != err { return fail(err) }
+		*o.getFileHolder() = f
+		opened = append(opened, o)
+		if err := o.checkValidator(o.path); nil != err { return fail(err) }
+	}
+
+	for _, o := range opened {
+		f := *o.getFileHolder()
+		if ! o.isWriteFile() || os.Stdout == f { continue }
+		if err := f.Truncate(0); nil != err {
+			return fail(errors.New(fmt.Sprintf(errorInvalidValueFileOpen, o.displayValue(o.path), o.getName(), o.displayError(err))))
+		}
+	}
+	return nil
+}
+
+// Close the files stored within the value holders of some options, and reset the value holders. The standard streams
+// are not closed.
+
+func closeFiles(inOptions []*Option) {
+	for _, o := range inOptions {
+		p := o.getFileHolder()
+		if nil == *p { continue }
+		if os.Stdin != *p && os.Stdout != *p { (*p).Close() }
+		*p = nil
+	}
+}
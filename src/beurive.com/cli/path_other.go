@@ -0,0 +1,16 @@
+//go:build !unix
+
+package cli
+
+import (
+	"os"
+)
+
+// Test whether the current user may write to an existing path (file or directory), without modifying the file system.
+// Please note that, on this platform, the test relies on the permission bits of the path only.
+
+func canWrite(inPath string) bool {
+	info, err := os.Stat(inPath)
+	if nil != err { return false }
+	return 0 != info.Mode().Perm() & 0200
+}
@@ -0,0 +1,251 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// -----------------------------------------------------------------
+// Test the expansion and the checking of paths.
+// -----------------------------------------------------------------
+
+func TestPathOk(t *testing.T)  {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "input.txt")
+	if err := os.WriteFile(file, []byte("data"), 0644); nil != err {
+		t.Fatal(err)
+	}
+	home, _ := os.UserHomeDir()
+	cwd, _ := os.Getwd()
+
+	var cloInput string
+	var cloOutput string
+	var cloDirs []string
+	var cloConfig string
+	var cloRelative string
+
+	spec := Spec{
+		Option{Short: "i", Long: "input",    Holder: &cloInput,    Kind: KindPath, PathFlags: PathMustBeFile},
+		Option{Short: "o", Long: "output",   Holder: &cloOutput,   Kind: KindPath, PathFlags: PathParentMustExist | PathMustBeWritable},
+		Option{Short: "d", Long: "dir",      Holder: &cloDirs,     Kind: KindPath, PathFlags: PathMustBeDir},
+		Option{Short: "c", Long: "config",   Holder: &cloConfig,   Kind: KindPath, PathFlags: PathExpandHome},
+		Option{Short: "r", Long: "relative", Holder: &cloRelative, Kind: KindPath, PathFlags: PathAbsolute},
+	}
+
+	input := []string{
+		"--input", file,
+		"--output", filepath.Join(dir, "output.txt"),
+		"-d", dir, "-d", os.TempDir(),
+		"--config", "~/.config/tool",
+		"-r", "data/file",
+	}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if file != cloInput {
+		t.Errorf(`Unexpected value: %s.`, cloInput)
+	}
+	if 2 != len(cloDirs) {
+		t.Errorf(`Unexpected values: %v.`, cloDirs)
+	}
+	if filepath.Join(home, ".config/tool") != cloConfig {
+		t.Errorf(`Unexpected value: %s.`, cloConfig)
+	}
+	if filepath.Join(cwd, "data/file") != cloRelative {
+		t.Errorf(`Unexpected value: %s.`, cloRelative)
+	}
+}
+
+func TestFileOk(t *testing.T)  {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "input.txt")
+	if err := os.WriteFile(file, []byte("data"), 0644); nil != err {
+		t.Fatal(err)
+	}
+
+	var cloInput *os.File
+	var cloOutput *os.File
+	var cloLog *os.File
+
+	spec := Spec{
+		Option{Short: "i", Long: "input",  Holder: &cloInput,  PathFlags: PathMustBeFile},
+		Option{Short: "o", Long: "output", Holder: &cloOutput, PathFlags: PathMustBeWritable},
+		Option{Short: "l", Long: "log",    Holder: &cloLog,    PathFlags: PathMustBeWritable},
+	}
+
+	input := []string{"--input", file, "--output", filepath.Join(dir, "output.txt"), "--log", "-"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	defer cloInput.Close()
+	defer cloOutput.Close()
+
+	if b, err := os.ReadFile(cloInput.Name()); nil != err || "data" != string(b) {
+		t.Errorf(`Unexpected file: %s.`, cloInput.Name())
+	}
+	if _, err := cloOutput.WriteString("result"); nil != err {
+		t.Errorf(`The output file should be writable: %s.`, err.Error())
+	}
+	if os.Stdout != cloLog {
+		t.Error(`The value "-" should represent the standard output.`)
+	}
+
+	// By default, files are opened for reading and "-" represents the standard input.
+	var cloStdin *os.File
+	o := Option{Short: "s", Long: "stdin", Holder: &cloStdin}
+	if _, _, err := Parse([]string{"--stdin", "-"}, Spec{ o }); nil != err || os.Stdin != cloStdin {
+		t.Error(`The value "-" should represent the standard input.`)
+	}
+}
+
+func TestFileNotModifiedOnError(t *testing.T)  {
+	dir := t.TempDir()
+	keep := filepath.Join(dir, "keep.txt")
+	if err := os.WriteFile(keep, []byte("data"), 0644); nil != err {
+		t.Fatal(err)
+	}
+	created := filepath.Join(dir, "created.txt")
+	missing := filepath.Join(dir, "missing.txt")
+
+	var cloOutput *os.File
+	var cloReport *os.File
+	var cloInput *os.File
+
+	spec := Spec{
+		Option{Short: "o", Long: "output", Holder: &cloOutput, PathFlags: PathMustBeWritable},
+		Option{Short: "r", Long: "report", Holder: &cloReport, PathFlags: PathMustBeWritable},
+		Option{Short: "i", Long: "input",  Holder: &cloInput},
+	}
+
+	// The files are neither created nor truncated if the command line is not valid, or if a file cannot be opened.
+	for i, input := range [][]string{
+		{"--output", keep, "--report", created, "--bogus"},
+		{"--output", keep, "--report", created, "--input", missing},
+	} {
+		if _, _, err := Parse(input, spec); nil == err {
+			t.Errorf(`Test #%d should fail!`, i)
+		}
+		if b, err := os.ReadFile(keep); nil != err || "data" != string(b) {
+			t.Errorf(`Test #%d failed. The file "%s" should not be modified.`, i, keep)
+		}
+		if _, err := os.Stat(created); ! os.IsNotExist(err) {
+			t.Errorf(`Test #%d failed. The file "%s" should not be created.`, i, created)
+		}
+		if nil != cloOutput || nil != cloReport {
+			t.Errorf(`Test #%d failed. The opened files should be closed.`, i)
+		}
+	}
+
+	// Checking that a directory is writable does not create files.
+	if ! isWritable(created) {
+		t.Errorf(`The directory "%s" should be writable.`, dir)
+	}
+	if entries, err := os.ReadDir(dir); nil != err || 1 != len(entries) {
+		t.Errorf(`The directory "%s" should not be modified.`, dir)
+	}
+
+	// Once the command line has been checked, the file opened for writing is truncated.
+	if _, _, err := Parse([]string{"--output", keep}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	defer cloOutput.Close()
+	if b, err := os.ReadFile(keep); nil != err || 0 != len(b) {
+		t.Errorf(`The file "%s" should be truncated.`, keep)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValuePath(t *testing.T)  {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "input.txt")
+	if err := os.WriteFile(file, []byte("data"), 0644); nil != err {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing", "file.txt")
+
+	var cloPath string
+	var cloFile *os.File
+
+	type setType struct {
+		option Option
+		value string
+		expected string
+	}
+	testSet := []setType{
+		{
+			option: Option{Long: "input", Holder: &cloPath, Kind: KindPath, PathFlags: PathMustExist},
+			value: missing,
+			expected: fmt.Sprintf(errorInvalidValuePathNotFound, missing, "input"),
+		},
+		{
+			option: Option{Long: "input", Holder: &cloPath, Kind: KindPath, PathFlags: PathMustBeFile},
+			value: dir,
+			expected: fmt.Sprintf(errorInvalidValuePathNotAFile, dir, "input"),
+		},
+		{
+			option: Option{Long: "dir", Holder: &cloPath, Kind: KindPath, PathFlags: PathMustBeDir},
+			value: file,
+			expected: fmt.Sprintf(errorInvalidValuePathNotADirectory, file, "dir"),
+		},
+		{
+			option: Option{Long: "output", Holder: &cloPath, Kind: KindPath, PathFlags: PathParentMustExist},
+			value: missing,
+			expected: fmt.Sprintf(errorInvalidValuePathNoParent, missing, "output"),
+		},
+		{
+			option: Option{Long: "input", Holder: &cloFile},
+			value: missing,
+			expected: fmt.Sprintf(errorInvalidValueFileOpen, missing, "input", fmt.Sprintf("open %s: no such file or directory", missing)),
+		},
+	}
+
+	for i, set := range testSet {
+		if _, _, err := Parse([]string{"--" + set.option.Long, set.value}, Spec{ set.option }); nil == err {
+			t.Errorf(`Test #%d should fail!`, i)
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
+
+func TestEM_InvalidPathDefinition(t *testing.T)  {
+	var cloPath string
+	var cloLevel int
+
+	type setType struct {
+		option Option
+		expected string
+	}
+	testSet := []setType{
+		{
+			option: Option{Long: "level", Holder: &cloLevel, Kind: KindPath},
+			expected: errorPathUnexpectedHolderType,
+		},
+		{
+			option: Option{Long: "path", Holder: &cloPath, PathFlags: PathMustExist},
+			expected: errorPathFlagsUnexpectedKind,
+		},
+		{
+			option: Option{Long: "path", Holder: &cloPath, Kind: KindPath, PathFlags: PathMustBeFile | PathMustBeDir},
+			expected: errorInvalidPathFlags,
+		},
+	}
+
+	for i, set := range testSet {
+		if err := set.option.init(); nil == err {
+			t.Errorf(`Test #%d: the option's specifier should not be valid!`, i)
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
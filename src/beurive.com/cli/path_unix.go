@@ -0,0 +1,17 @@
+//go:build unix
+
+package cli
+
+import (
+	"syscall"
+)
+
+// The mode used to test whether a path is writable (see access(2)).
+
+const accessWritable = 0x2 // W_OK
+
+// Test whether the current user may write to an existing path (file or directory), without modifying the file system.
+
+func canWrite(inPath string) bool {
+	return nil == syscall.Access(inPath, accessWritable)
+}
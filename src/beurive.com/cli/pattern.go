@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+// Check that the constraints on the values of a string option are valid:
+// - A pattern or a length can only be specified for strings (or lists of strings).
+// - The pattern must be a valid regular expression.
+// - The minimum length must not be greater than the maximum length.
+
+func (o *Option) initPattern() error {
+	if "" == o.Pattern && 0 == o.MinLength && 0 == o.MaxLength { return nil }
+
+	if familyString != o.getFamily() {
+		return errors.New(errorPatternUnexpectedHolderType)
+	}
+	if "" != o.Pattern {
+		if _, err := regexp.Compile(o.Pattern); nil != err {
+			return errors.New(fmt.Sprintf(errorInvalidPattern, o.Pattern, err.Error()))
+		}
+	}
+	if o.MinLength < 0 || o.MaxLength < 0 || (o.MaxLength > 0 && o.MinLength > o.MaxLength) {
+		return errors.New(fmt.Sprintf(errorInvalidLengthRange, o.MinLength, o.MaxLength))
+	}
+	return nil
+}
+
+// Check that a string value matches the constraints defined for an option (pattern and length).
+// Please note that the length of a string is its number of characters (not its number of bytes).
+// If the option defines a description of the pattern, then this description is used within the error message instead
+// of the regular expression.
+
+func (o *Option) checkPattern(inValue string) error {
+	if familyString != o.getFamily() { return nil }
+
+	if "" != o.Pattern {
+		// The regular expression has been checked while the option was initialised.
+		rx := regexp.MustCompile(o.Pattern)
+		if ! rx.MatchString(inValue) {
+			if "" != o.PatternDescription {
+				return errors.New(fmt.Sprintf(errorInvalidValueDescription, o.displayValue(inValue), o.getName(), o.PatternDescription))
+			}
+			return errors.New(fmt.Sprintf(errorInvalidValuePattern, o.displayValue(inValue), o.getName(), o.Pattern))
+		}
+	}
+
+	l := utf8.RuneCountInString(inValue)
+	if l < o.MinLength {
+		return errors.New(fmt.Sprintf(errorInvalidValueTooShort, o.displayValue(inValue), o.getName(), o.MinLength))
+	}
+	if o.MaxLength > 0 && l > o.MaxLength {
+		return errors.New(fmt.Sprintf(errorInvalidValueTooLong, o.displayValue(inValue), o.getName(), o.MaxLength))
+	}
+	return nil
+}
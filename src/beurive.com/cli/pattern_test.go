@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+)
+
+// -----------------------------------------------------------------
+// Test the validation of string values against patterns and lengths.
+// -----------------------------------------------------------------
+
+func TestPatternOk(t *testing.T)  {
+	var cloName string
+	var cloTags []string
+
+	spec := Spec{
+		Option{Short: "n", Long: "name", Holder: &cloName, Pattern: `^[a-z][a-z0-9-]*$`, MaxLength: 8},
+		Option{Short: "t", Long: "tag",  Holder: &cloTags, MinLength: 2, MaxLength: 3},
+	}
+
+	input := []string{"--name", "api-v2", "-t", "été", "-t", "ab"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Errorf(`Unexpected error: %s`, err.Error())
+	}
+	if "api-v2" != cloName {
+		t.Errorf(`Unexpected value "%s".`, cloName)
+	}
+	if 2 != len(cloTags) {
+		t.Errorf(`Unexpected values %v.`, cloTags)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValuePattern(t *testing.T)  {
+	var cloName string
+	var cloTags []string
+
+	type setType struct {
+		spec Spec
+		input []string
+		expected string
+	}
+	testSet := []setType{
+		{
+			spec: Spec{ Option{Short: "n", Long: "name", Holder: &cloName, Pattern: `^[a-z]+$`} },
+			input: []string{ "--name", "Api" },
+			expected: fmt.Sprintf(errorInvalidValuePattern, "Api", "name", `^[a-z]+$`),
+		},
+		{
+			spec: Spec{ Option{Short: "n", Long: "name", Holder: &cloName, Pattern: `^[a-z]+$`, PatternDescription: "a lowercase word"} },
+			input: []string{ "--name", "Api" },
+			expected: fmt.Sprintf(errorInvalidValueDescription, "Api", "name", "a lowercase word"),
+		},
+		{
+			spec: Spec{ Option{Short: "t", Long: "", Holder: &cloTags, MinLength: 2} },
+			input: []string{ "-t", "ab", "-t", "a" },
+			expected: fmt.Sprintf(errorInvalidValueTooShort, "a", "t", 2),
+		},
+		{
+			spec: Spec{ Option{Short: "t", Long: "", Holder: &cloTags, MaxLength: 2} },
+			input: []string{ "-t", "abc" },
+			expected: fmt.Sprintf(errorInvalidValueTooLong, "abc", "t", 2),
+		},
+	}
+
+	for i, set := range testSet {
+		if _, _, err := Parse(set.input, set.spec); nil == err {
+			t.Errorf(`The test number %d should NOT be OK (%s)`, i, strings.Join(set.input, " "))
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
+
+func TestEM_InvalidPatternDefinition(t *testing.T)  {
+	var cloName string
+	var cloValue int
+
+	type setType struct {
+		option Option
+		expected string
+	}
+	testSet := []setType{
+		{
+			option: Option{Long: "value", Holder: &cloValue, Pattern: `^[0-9]+$`},
+			expected: errorPatternUnexpectedHolderType,
+		},
+		{
+			option: Option{Long: "name", Holder: &cloName, Pattern: `^[a-z+$`},
+			expected: fmt.Sprintf(errorInvalidPattern, `^[a-z+$`, "error parsing regexp: missing closing ]: `[a-z+$`"),
+		},
+		{
+			option: Option{Long: "name", Holder: &cloName, MinLength: 4, MaxLength: 2},
+			expected: fmt.Sprintf(errorInvalidLengthRange, 4, 2),
+		},
+	}
+
+	for i, set := range testSet {
+		if err := set.option.init(); nil == err {
+			t.Errorf(`Test #%d: the option's specifier should not be valid!`, i)
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// The default maximum number of values stored within a list of integers, for an option which kind is KindRanges.
+
+const defaultMaxExpansion = 65536
+
+// Return the maximum number of values that can be stored within a list of integers, for an option which kind is
+// KindRanges.
+
+func (o *Option) getMaxExpansion() int {
+	if o.MaxExpansion > 0 { return o.MaxExpansion }
+	return defaultMaxExpansion
+}
+
+// Convert the bound of a range into an integer, according to the option's numeric syntax.
+
+func (o *Option) parseRangeBound(inBound string) (*big.Int, bool) {
+	if "" == inBound { return nil, false }
+	bound, ok := new(big.Int).SetString(inBound, o.getBase())
+	if ! ok { return nil, false }
+	if familyUnsigned == o.getFamily() && bound.Sign() < 0 { return nil, false }
+	return bound, true
+}
+
+// Return the smallest and the largest integers that can be stored within the elements of the option's value holder
+// (ex: 0 and 255 for a list of uint8).
+
+func (o *Option) getRangeLimits() (*big.Int, *big.Int) {
+	t := reflect.TypeOf(o.Holder).Elem().Elem()
+	if familyUnsigned == o.getFamily() {
+		max := new(big.Int).Lsh(big.NewInt(1), uint(t.Bits()))
+		return big.NewInt(0), max.Sub(max, big.NewInt(1))
+	}
+	max := new(big.Int).Lsh(big.NewInt(1), uint(t.Bits() - 1))
+	min := new(big.Int).Neg(max)
+	return min, max.Sub(max, big.NewInt(1))
+}
+
+// Expand a list of integers and ranges of integers (ex: "0-3,8,10-11") and add all the resulting integers to the
+// option's value holder (ex: 0, 1, 2, 3, 8, 10 and 11).
+// The following checks are performed:
+// - The lower bound of a range must not be greater than its upper bound (ex: "3-0" is not valid).
+// - The bounds must fit within the elements of the option's value holder (ex: "250-260" is not valid for []uint8).
+// - An integer must not appear more than once (ex: "0-3,2" is not valid), including across occurrences of the option.
+// - The number of integers stored within the option's value holder must not exceed the option's maximum expansion.
+// Each integer is then added as if it was given on its own (thus, ranges of values, lists of allowed values and
+// validators apply to each integer). If an integer is rejected, then none of the integers is added.
+
+func (o *Option) addRanges(inValue string) error {
+	var rx *regexp.Regexp = regexp.MustCompile(`^(-?[^-]+)(?:-(-?[^-]+))?$`)
+
+	// Collect the integers already stored within the value holder.
+	holder := reflect.ValueOf(o.Holder).Elem()
+	seen := make(map[string]bool)
+	for i := 0; i < holder.Len(); i++ {
+		seen[fmt.Sprintf(`%d`, holder.Index(i).Interface())] = true
+	}
+	count := holder.Len()
+	initial := count
+	min, max := o.getRangeLimits()
+
+	values := make([]string, 0)
+	for _, part := range strings.Split(inValue, `,`) {
+		m := rx.FindStringSubmatch(strings.TrimSpace(part))
+		if nil == m {
+			return errors.New(fmt.Sprintf(errorInvalidValueRangesExpected, o.displayValue(inValue), o.getName()))
+		}
+		lo, ok := o.parseRangeBound(m[1])
+		if ! ok {
+			return errors.New(fmt.Sprintf(errorInvalidValueRangesExpected, o.displayValue(inValue), o.getName()))
+		}
+		hi := lo
+		if "" != m[2] {
+			if hi, ok = o.parseRangeBound(m[2]); ! ok {
+				return errors.New(fmt.Sprintf(errorInvalidValueRangesExpected, o.displayValue(inValue), o.getName()))
+			}
+		}
+		if lo.Cmp(hi) > 0 {
+			return errors.New(fmt.Sprintf(errorInvalidValueReversedRange, o.displayValue(inValue), o.getName(), o.displayValue(strings.TrimSpace(part))))
+		}
+		if lo.Cmp(min) < 0 || hi.Cmp(max) > 0 {
+			return errors.New(fmt.Sprintf(errorInvalidValueRangeOverflow, o.displayValue(inValue), o.getName(), o.displayValue(strings.TrimSpace(part)), min.String(), max.String()))
+		}
+
+		// Check the length of the list before expanding the range.
+		length := new(big.Int).Sub(hi, lo)
+		length.Add(length, big.NewInt(int64(count + 1)))
+		if length.Cmp(big.NewInt(int64(o.getMaxExpansion()))) > 0 {
+			return errors.New(fmt.Sprintf(errorInvalidValueRangesTooLong, o.displayValue(inValue), o.getName(), o.getMaxExpansion()))
+		}
+
+		for n := new(big.Int).Set(lo); n.Cmp(hi) <= 0; n.Add(n, big.NewInt(1)) {
+			s := n.String()
+			if seen[s] {
+				return errors.New(fmt.Sprintf(errorInvalidValueOverlappingRanges, o.displayValue(inValue), o.getName(), o.displayValue(s)))
+			}
+			seen[s] = true
+			values = append(values, s)
+			count++
+		}
+	}
+
+	// The integers are written in base 10, without leading zeros, which is valid for all numeric syntaxes. Add them one
+	// by one, as if they were given without ranges. The option's numeric syntax is kept, so that the allowed values
+	// (see the attribute "Choices") are read as they are within the specification (ex: "0x1").
+	element := *o
+	element.Kind = KindDefault
+	for _, v := range values {
+		if err := element.addValue(v); nil != err {
+			holder.Set(holder.Slice(0, initial))
+			return err
+		}
+	}
+	return nil
+}
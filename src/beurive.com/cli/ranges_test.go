@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+)
+
+// -----------------------------------------------------------------
+// Test the expansion of lists of integers and ranges.
+// -----------------------------------------------------------------
+
+func TestRangesOk(t *testing.T)  {
+	var cloCpus []int
+	var cloPorts []uint16
+	var cloLines []int64
+	var cloNodes []int
+
+	spec := Spec{
+		Option{Short: "c", Long: "cpus",  Holder: &cloCpus,  Kind: KindRanges},
+		Option{Short: "p", Long: "ports", Holder: &cloPorts, Kind: KindRanges, NumericSyntax: SyntaxGo, Min: 1},
+		Option{Short: "l", Long: "lines", Holder: &cloLines, Kind: KindRanges},
+		Option{Short: "n", Long: "nodes", Holder: &cloNodes, Kind: KindRanges, NumericSyntax: SyntaxGo, Choices: []string{"0x1", "0x2", "0b100"}},
+	}
+
+	input := []string{"--cpus", "0-3,8,10-11", "-p", "0x50,8080-8082", "-l", "5", "-l", "1-2", "-n", "1-2,0x4"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if "[0 1 2 3 8 10 11]" != fmt.Sprint(cloCpus) {
+		t.Errorf(`Unexpected values: %v.`, cloCpus)
+	}
+	if "[80 8080 8081 8082]" != fmt.Sprint(cloPorts) {
+		t.Errorf(`Unexpected values: %v.`, cloPorts)
+	}
+	if "[5 1 2]" != fmt.Sprint(cloLines) {
+		t.Errorf(`Unexpected values: %v.`, cloLines)
+	}
+	if "[1 2 4]" != fmt.Sprint(cloNodes) {
+		t.Errorf(`Unexpected values: %v.`, cloNodes)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValueRanges(t *testing.T)  {
+	var cloCpus []int
+	var cloPorts []uint8
+
+	type setType struct {
+		spec Spec
+		input []string
+		expected string
+	}
+	testSet := []setType{
+		{
+			spec: Spec{ Option{Short: "c", Long: "cpus", Holder: &cloCpus, Kind: KindRanges} },
+			input: []string{ "--cpus", "0-3,,4" },
+			expected: fmt.Sprintf(errorInvalidValueRangesExpected, "0-3,,4", "cpus"),
+		},
+		{
+			spec: Spec{ Option{Short: "c", Long: "cpus", Holder: &cloCpus, Kind: KindRanges} },
+			input: []string{ "--cpus", "0x1-0x3" },
+			expected: fmt.Sprintf(errorInvalidValueRangesExpected, "0x1-0x3", "cpus"),
+		},
+		{
+			spec: Spec{ Option{Short: "c", Long: "cpus", Holder: &cloCpus, Kind: KindRanges} },
+			input: []string{ "--cpus", "0,3-1" },
+			expected: fmt.Sprintf(errorInvalidValueReversedRange, "0,3-1", "cpus", "3-1"),
+		},
+		{
+			spec: Spec{ Option{Short: "c", Long: "cpus", Holder: &cloCpus, Kind: KindRanges} },
+			input: []string{ "--cpus", "0-3,2" },
+			expected: fmt.Sprintf(errorInvalidValueOverlappingRanges, "0-3,2", "cpus", "2"),
+		},
+		{
+			spec: Spec{ Option{Short: "c", Long: "cpus", Holder: &cloCpus, Kind: KindRanges} },
+			input: []string{ "--cpus", "0-3", "-c", "3" },
+			expected: fmt.Sprintf(errorInvalidValueOverlappingRanges, "3", "cpus", "3"),
+		},
+		{
+			spec: Spec{ Option{Short: "c", Long: "cpus", Holder: &cloCpus, Kind: KindRanges, MaxExpansion: 4} },
+			input: []string{ "--cpus", "0-1", "--cpus", "2-4" },
+			expected: fmt.Sprintf(errorInvalidValueRangesTooLong, "2-4", "cpus", 4),
+		},
+		{
+			spec: Spec{ Option{Short: "c", Long: "cpus", Holder: &cloCpus, Kind: KindRanges} },
+			input: []string{ "--cpus", "0-9223372036854775807" },
+			expected: fmt.Sprintf(errorInvalidValueRangesTooLong, "0-9223372036854775807", "cpus", defaultMaxExpansion),
+		},
+		{
+			spec: Spec{ Option{Short: "p", Long: "", Holder: &cloPorts, Kind: KindRanges} },
+			input: []string{ "-p", "250-256" },
+			expected: fmt.Sprintf(errorInvalidValueRangeOverflow, "250-256", "p", "250-256", "0", "255"),
+		},
+		{
+			spec: Spec{ Option{Short: "p", Long: "", Holder: &cloPorts, Kind: KindRanges, NumericSyntax: SyntaxGo} },
+			input: []string{ "-p", "1,-1-2" },
+			expected: fmt.Sprintf(errorInvalidValueRangesExpected, "1,-1-2", "p"),
+		},
+		{
+			spec: Spec{ Option{Short: "p", Long: "", Holder: &cloPorts, Kind: KindRanges, Choices: []string{"1", "2"}} },
+			input: []string{ "-p", "1-3" },
+			expected: fmt.Sprintf(errorInvalidValueNotAllowed, "3", "p", "1, 2"),
+		},
+	}
+
+	for i, set := range testSet {
+		cloCpus = nil
+		cloPorts = nil
+		if _, _, err := Parse(set.input, set.spec); nil == err {
+			t.Errorf(`The test number %d should NOT be OK (%s)`, i, strings.Join(set.input, " "))
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+			if 0 != len(cloPorts) {
+				t.Errorf(`Test #%d failed. The value holder should not be partially filled: %v`, i, cloPorts)
+			}
+		}
+	}
+}
+
+func TestEM_RangesUnexpectedHolderType(t *testing.T)  {
+	var cloCpu int
+
+	o := Option{Short: "c", Long: "cpu", Holder: &cloCpu, Kind: KindRanges}
+	if err := o.init(); nil == err {
+		t.Error("Option's specifier should not be valid!")
+	} else {
+		if 0 != strings.Compare(err.Error(), errorRangesUnexpectedHolderType) {
+			t.Errorf(`Test failed. Got [%s] / [%s]`, err.Error(), errorRangesUnexpectedHolderType)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Check that the kind of values KindGlob is compatible with the type of the option's value holder.
+
+func (o *Option) initGlob() error {
+	if familyString != o.getFamily() {
+		return errors.New(errorGlobUnexpectedHolderType)
+	}
+	return nil
+}
+
+// Compile a regular expression. If the regular expression is not valid, then the error message contains the name of
+// the option and the reason of the failure.
+
+func (o *Option) parseRegexp(inValue string) (*regexp.Regexp, error) {
+	rx, err := regexp.Compile(inValue)
+	if nil != err {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueRegexp, o.displayValue(inValue), o.getName(), strings.TrimPrefix(o.displayError(err), `error parsing regexp: `)))
+	}
+	return rx, nil
+}
+
+// Check that a value is a valid glob pattern, as defined by the function path.Match (ex: "*.log" or "api/[a-z]*").
+
+func (o *Option) checkGlob(inValue string) error {
+	if _, err := path.Match(inValue, ""); nil != err {
+		return errors.New(fmt.Sprintf(errorInvalidValueGlob, o.displayValue(inValue), o.getName()))
+	}
+	return nil
+}
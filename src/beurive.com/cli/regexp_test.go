@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"regexp"
+)
+
+// -----------------------------------------------------------------
+// Test the regular expressions and the glob patterns.
+// -----------------------------------------------------------------
+
+func TestRegexpOk(t *testing.T)  {
+	var cloInclude regexp.Regexp
+	var cloExclude []*regexp.Regexp
+	var cloOptional *regexp.Regexp
+	var cloFiles []string
+
+	spec := Spec{
+		Option{Short: "i", Long: "include",  Holder: &cloInclude},
+		Option{Short: "e", Long: "exclude",  Holder: &cloExclude},
+		Option{Short: "o", Long: "optional", Holder: &cloOptional},
+		Option{Short: "f", Long: "files",    Holder: &cloFiles, Kind: KindGlob},
+	}
+
+	input := []string{"--include", "^api/.*", "-e", `\.tmp$`, "-e", "~$", "-f", "*.log", "-f", "api/[a-z]*"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if ! cloInclude.MatchString("api/v1") || cloInclude.MatchString("web/api") {
+		t.Errorf(`Unexpected regular expression: %s.`, cloInclude.String())
+	}
+	if 2 != len(cloExclude) || ! cloExclude[0].MatchString("file.tmp") || ! cloExclude[1].MatchString("file~") {
+		t.Errorf(`Unexpected regular expressions: %v.`, cloExclude)
+	}
+	if nil != cloOptional {
+		t.Errorf(`Unexpected regular expression: %s.`, cloOptional.String())
+	}
+	if "*.log api/[a-z]*" != strings.Join(cloFiles, " ") {
+		t.Errorf(`Unexpected glob patterns: %v.`, cloFiles)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestRegexpNilHolderOk(t *testing.T)  {
+	// A nil value holder is allocated when the option appears within the command line.
+	spec := Spec{ Option{Long: "include", Holder: (*regexp.Regexp)(nil)} }
+	if _, _, err := Parse([]string{"--include", "^api/"}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if rx, _ := spec.Lookup("include").Holder.(*regexp.Regexp); nil == rx || ! rx.MatchString("api/v1") {
+		t.Errorf(`Unexpected regular expression: %v.`, spec.Lookup("include").Holder)
+	}
+}
+
+func TestEM_RegexpKo(t *testing.T)  {
+	type testSet struct {
+		option Option
+		input []string
+		expected string
+	}
+
+	var cloInclude regexp.Regexp
+	var cloExclude []*regexp.Regexp
+	var cloCount int
+	var cloFiles []string
+
+	tests := []testSet{
+		{option: Option{Long: "count", Holder: &cloCount, Kind: KindGlob},
+			expected: errorGlobUnexpectedHolderType},
+		{option: Option{Long: "include", Holder: &cloInclude, Pattern: "^a"},
+			expected: errorPatternUnexpectedHolderType},
+		{option: Option{Long: "include", Holder: &cloInclude},
+			input: []string{"--include", "^api/(.*"},
+			expected: "Invalid value \"^api/(.*\" for option \"include\". Invalid regular expression (missing closing ): `^api/(.*`)."},
+		{option: Option{Long: "exclude", Holder: &cloExclude},
+			input: []string{"--exclude", "a**"},
+			expected: "Invalid value \"a**\" for option \"exclude\". Invalid regular expression (invalid nested repetition operator: `**`)."},
+		{option: Option{Long: "files", Holder: &cloFiles, Kind: KindGlob},
+			input: []string{"--files", "[a-"},
+			expected: `Invalid value "[a-" for option "files". Expected a glob pattern (ex: *.log).`},
+	}
+
+	for i, set := range tests {
+		_, _, err := Parse(set.input, Spec{ set.option })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The type MissingOptionsError represents the error returned when required options do not appear within the command
+// line (see the attribute "Required").
+// * The attribute "Names" contains the names of the missing options, as they should appear within the command line
+//   (ex: "--input" or "-i"), in the order of the specification.
+
+type MissingOptionsError struct {
+	Names []string // The names of the missing options.
+}
+
+func (e *MissingOptionsError) Error() string {
+	if 1 == len(e.Names) {
+		return fmt.Sprintf(errorMissingRequiredOption, e.Names[0])
+	}
+	return fmt.Sprintf(errorMissingRequiredOptions, strings.Join(e.Names, `, `))
+}
+
+// Return the name used to designate an option within the messages that refer to the command line (ex: "--input" or
+// "-i"). The long name is preferred.
+
+func (o *Option) getSpecifier() string {
+	if "" != o.Long { return `--` + o.Long }
+	return `-` + o.Short
+}
+
+// Check that all the required options appear within the command line.
+// If some required options are missing, then the function returns an error of type *MissingOptionsError that lists all
+// of them. Please note that default values do not satisfy the requirement, and neither does a flag given the value
+// false (ex: "--force=no").
+
+func checkRequired(inSpec Spec) error {
+	missing := make([]string, 0)
+	for i := range inSpec {
+		if inSpec[i].Required && ! inSpec[i].isEnabled() {
+			missing = append(missing, inSpec[i].getSpecifier())
+		}
+	}
+	if 0 == len(missing) { return nil }
+	return &MissingOptionsError{Names: missing}
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"testing"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// -----------------------------------------------------------------
+// Test the required options.
+// -----------------------------------------------------------------
+
+func TestRequiredOk(t *testing.T)  {
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password")
+	if err := os.WriteFile(passwordFile, []byte("p@ss\n"), 0600); nil != err {
+		t.Fatal(err)
+	}
+
+	var cloInput string
+	var cloPassword string
+	var cloVerbose bool
+
+	spec := Spec{
+		Option{Short: "i", Long: "input",    Holder: &cloInput,    Required: true},
+		Option{Short: "p", Long: "password", Holder: &cloPassword, Required: true, FileOption: true},
+		Option{Short: "v", Long: "verbose",  Holder: &cloVerbose},
+	}
+
+	for i, input := range [][]string{
+		{"-i", "/path/to/input", "-p", "secret"},
+		{"--input=/path/to/input", "--password-file", passwordFile, "-v"},
+	} {
+		if _, _, err := Parse(input, spec); nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_RequiredKo(t *testing.T)  {
+	var cloInput string
+	var cloOutput string
+	var cloLevel int
+
+	spec := Spec{
+		Option{Long: "input",  Holder: &cloInput,  Required: true},
+		Option{Short: "o",     Holder: &cloOutput, Required: true},
+		Option{Long: "level",  Holder: &cloLevel,  Required: true, Default: 3},
+	}
+
+	type testSet struct {
+		input []string
+		expected string
+		names int
+	}
+
+	for i, set := range []testSet{
+		{input: []string{}, expected: `Missing required options: --input, -o, --level.`, names: 3},
+		{input: []string{"-o", "/tmp/output", "--level", "1"}, expected: `Missing required option: --input.`, names: 1},
+	} {
+		_, _, err := Parse(set.input, spec)
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		var missing *MissingOptionsError
+		if ! errors.As(err, &missing) || set.names != len(missing.Names) {
+			t.Errorf(`Test #%d failed! Unexpected error type: %#v`, i, err)
+		}
+		if set.expected != err.Error() {
+			t.Errorf(`Test #%d failed! Got "%s", expected "%s".`, i, err.Error(), set.expected)
+		}
+	}
+}
+
+func TestEM_RequiredWithoutValue(t *testing.T)  {
+	var cloInput string
+
+	// The option appears within the command line, but its value is missing.
+	for i, input := range [][]string{
+		{"--in"},
+		{"-i"},
+	} {
+		_, _, err := Parse(input, Spec{ Option{Short: "i", Long: "in", Holder: &cloInput, Required: true} })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if expected := `Unexpected end of command line. A value was expected for option "in".`; expected != err.Error() {
+			t.Errorf(`Test #%d failed! Got "%s", expected "%s".`, i, err.Error(), expected)
+		}
+	}
+}
+
+func TestEM_RequiredFlagFalse(t *testing.T)  {
+	var cloForce bool
+
+	// A required flag given the value false does not satisfy the requirement.
+	for i, input := range [][]string{
+		{"--force=false"},
+		{"-f=no"},
+	} {
+		_, _, err := Parse(input, Spec{ Option{Short: "f", Long: "force", Holder: &cloForce, Required: true} })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if expected := `Missing required option: --force.`; expected != err.Error() {
+			t.Errorf(`Test #%d failed! Got "%s", expected "%s".`, i, err.Error(), expected)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Check that the kind of values KindRest is compatible with the option's definition:
+// - The value holder must be a list of strings.
+// - The values cannot be read from files.
+
+func (o *Option) initRest() error {
+	if t, _ := o.getType(); TypeStrings != t {
+		return errors.New(errorRestUnexpectedHolderType)
+	}
+	if o.FileOption || "" != o.FileMarker {
+		return errors.New(errorRestUnexpectedSource)
+	}
+	return nil
+}
+
+// Add the tokens that follow a rest-of-line option (ex: "--exec grep -n foo ;") to the option. The tokens are taken
+// verbatim, up to the option's terminator (which is consumed), or up to the end of the command line if the option has
+// no terminator. If the option has a terminator, then the terminator must appear within the command line.
+// The function returns the strings that represent the tokens within the expanded command line, and the number of
+// tokens consumed (including the terminator).
+
+func addRest(inOption *Option, inSpecifier string, inTokens []string) ([]string, int, error) {
+	if inOption.occurrences > 1 {
+		return nil, 0, errors.New(fmt.Sprintf(errorDuplicatedRestOption, inSpecifier))
+	}
+	if inOption.defaulted {
+		// The tokens given within the command line replace the default value, even if there is no token.
+		inOption.clearDefault()
+	}
+
+	count := len(inTokens)
+	if "" != inOption.Terminator {
+		count = -1
+		for i, token := range inTokens {
+			if inOption.Terminator == token {
+				count = i
+				break
+			}
+		}
+		if count < 0 {
+			return nil, 0, errors.New(fmt.Sprintf(errorMissingRestTerminator, inSpecifier, inOption.Terminator))
+		}
+	}
+
+	expanded := []string{inSpecifier}
+	for _, token := range inTokens[:count] {
+		if err := inOption.addValue(token); nil != err { return nil, 0, err }
+		expanded = append(expanded, inOption.displayValue(token))
+	}
+	if "" == inOption.Terminator { return expanded, count, nil }
+	return append(expanded, inOption.Terminator), count + 1, nil
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"reflect"
+)
+
+// -----------------------------------------------------------------
+// Test the rest-of-line options.
+// -----------------------------------------------------------------
+
+func TestRestOk(t *testing.T)  {
+	var cloExec []string
+	var cloCommand []string
+	var cloVerbose bool
+
+	spec := Spec{
+		Option{Short: "e", Long: "exec",    Holder: &cloExec, Kind: KindRest, Terminator: ";"},
+		Option{Short: "c", Long: "command", Holder: &cloCommand, Kind: KindRest, Default: []string{"true"}},
+		Option{Short: "v", Long: "verbose", Holder: &cloVerbose},
+	}
+
+	type testSet struct {
+		input []string
+		exec []string
+		command []string
+		verbose bool
+		cli []string
+		args []string
+	}
+
+	for i, set := range []testSet{
+		{input: []string{"--exec", "grep", "-n", "foo", ";", "-v", "x"},
+			exec: []string{"grep", "-n", "foo"}, command: []string{"true"}, verbose: true,
+			cli: []string{"--exec", "grep", "-n", "foo", ";", "-v", "x"}, args: []string{"x"}},
+		{input: []string{"-e", ";", "-c", "ls", "--", "-l"},
+			exec: []string{}, command: []string{"ls", "--", "-l"},
+			cli: []string{"-e", ";", "-c", "ls", "--", "-l"}, args: []string{}},
+		{input: []string{"-v", "--command"},
+			exec: []string{}, command: []string{}, verbose: true,
+			cli: []string{"-v", "--command"}, args: []string{}},
+	} {
+		cloExec = []string{}
+		cloCommand = []string{}
+		cloVerbose = false
+		cli, args, err := Parse(set.input, spec)
+		if nil != err {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+			continue
+		}
+		if ! reflect.DeepEqual(set.exec, cloExec) || ! reflect.DeepEqual(set.command, cloCommand) || set.verbose != cloVerbose {
+			t.Errorf(`Test #%d failed! Unexpected values: %v, %v, %t`, i, cloExec, cloCommand, cloVerbose)
+		}
+		if ! reflect.DeepEqual(set.args, args) {
+			t.Errorf(`Test #%d failed! Unexpected arguments: %v`, i, args)
+		}
+		if ! reflect.DeepEqual(set.cli, cli) {
+			t.Errorf(`Test #%d failed! Unexpected command line: %v`, i, cli)
+		}
+	}
+}
+
+func TestRestUsageOk(t *testing.T)  {
+	var cloExec []string
+	var cloCommand []string
+
+	spec := Spec{
+		Option{Long: "exec",    Holder: &cloExec, Kind: KindRest, Terminator: ";"},
+		Option{Short: "c",      Holder: &cloCommand, Kind: KindRest},
+	}
+	expected := `find [--exec <exec>... ;] [-c <value>...]`
+	if got := Synopsis("find", spec); expected != got {
+		t.Errorf("Unexpected synopsis!\nGot:      %s\nExpected: %s", got, expected)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_RestKo(t *testing.T)  {
+	type testSet struct {
+		option Option
+		input []string
+		expected string
+	}
+
+	var cloExec []string
+	var cloName string
+	var cloCounts []int
+
+	for i, set := range []testSet{
+		{option: Option{Long: "exec", Holder: &cloName, Kind: KindRest},
+			expected: errorRestUnexpectedHolderType},
+		{option: Option{Long: "exec", Holder: &cloCounts, Kind: KindRest},
+			expected: errorRestUnexpectedHolderType},
+		{option: Option{Long: "exec", Holder: &cloExec, Kind: KindRest, FileOption: true},
+			expected: errorRestUnexpectedSource},
+		{option: Option{Long: "exec", Holder: &cloExec, Terminator: ";"},
+			expected: errorTerminatorUnexpectedKind},
+		{option: Option{Long: "exec", Holder: &cloExec, Kind: KindRest},
+			input: []string{"--exec=ls"},
+			expected: `Invalid option specifier "--exec". A value cannot be attached to a rest-of-line option.`},
+		{option: Option{Short: "e", Holder: &cloExec, Kind: KindRest, Terminator: ";"},
+			input: []string{"-e", "ls", ";", "-e", "pwd", ";"},
+			expected: `Duplicated use of rest-of-line option "-e".`},
+		{option: Option{Long: "exec", Holder: &cloExec, Kind: KindRest, Terminator: ";"},
+			input: []string{"--exec", "grep", "-n", "foo"},
+			expected: `The option --exec must be terminated by ";".`},
+	} {
+		_, _, err := Parse(set.input, Spec{ set.option })
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if ! strings.Contains(err.Error(), set.expected) {
+			t.Errorf(`Test #%d failed! Unexpected error: "%s"`, i, err.Error())
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// The mask that replaces the values of secret options (within the expanded command line, the error messages and the
+// debug dumps).
+
+const secretMask = `******`
+
+// Return the representation of a value of an option, as it can be displayed.
+// If the option is secret, then the function returns the mask. Otherwise, it returns the given value.
+
+func (o *Option) displayValue(inValue string) string {
+	if o.Secret { return secretMask }
+	return inValue
+}
+
+// Remove the value of a secret option from an error returned by the GO standard library.
+// Errors returned by the package "strconv" contain the value that could not be parsed (ex: `strconv.ParseInt:
+// parsing "s3cr3t": invalid syntax`).
+
+func (o *Option) maskError(inError error) error {
+	if ! o.Secret { return inError }
+
+	var numError *strconv.NumError
+	if errors.As(inError, &numError) {
+		return &strconv.NumError{Func: numError.Func, Num: secretMask, Err: numError.Err}
+	}
+	return inError
+}
+
+// Return the text of an error returned by the GO standard library, as it can be displayed within an error message.
+// If the option is secret, then the function returns the mask, since the text may quote the value (ex: the regular
+// expression, or the path of a file).
+
+func (o *Option) displayError(inError error) string {
+	if o.Secret { return secretMask }
+	return inError.Error()
+}
+
+// Return a human-readable representation of an option, for debugging purposes.
+// The value stored within the option's value holder is replaced by the mask if the option is secret.
+// Example: Option{Short: "p", Long: "password", Value: "******"}
+
+func (o Option) String() string {
+	value := `<nil>`
+	v := reflect.ValueOf(o.Holder)
+	if o.isIndirect() && ! v.IsNil() { v = v.Elem() }
+	if v.IsValid() && reflect.Ptr == v.Kind() && ! v.IsNil() {
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			// Some types only implement fmt.Stringer through pointers (ex: *big.Int).
+			value = s.String()
+		} else {
+			value = fmt.Sprintf(`%v`, v.Elem().Interface())
+		}
+	}
+	return fmt.Sprintf(`Option{Short: %q, Long: %q, Value: %q}`, o.Short, o.Long, o.displayValue(value))
+}
+
+// Return the GO-syntax representation of an option (used by the verb "%#v").
+// Please note that the value of a secret option is masked.
+
+func (o Option) GoString() string {
+	return o.String()
+}
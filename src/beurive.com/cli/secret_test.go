@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// -----------------------------------------------------------------
+// Test the secret options.
+// -----------------------------------------------------------------
+
+func TestSecretOk(t *testing.T)  {
+	var cloPassword string
+	var cloUser string
+
+	spec := Spec{
+		Option{Short: "p", Long: "password", Holder: &cloPassword, Secret: true},
+		Option{Short: "u", Long: "user",     Holder: &cloUser},
+	}
+
+	cli, _, err := Parse([]string{"-p", "s3cr3t", "--user", "admin"}, spec)
+	if nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if "s3cr3t" != cloPassword || "admin" != cloUser {
+		t.Errorf(`Unexpected values: "%s", "%s".`, cloPassword, cloUser)
+	}
+	expected := []string{"-p", secretMask, "--user", "admin"}
+	if strings.Join(expected, " ") != strings.Join(cli, " ") {
+		t.Errorf(`Unexpected command line. Got %v, expected %v.`, cli, expected)
+	}
+
+	// Debug dumps.
+	for _, dump := range []string{fmt.Sprintf(`%v`, spec[0]), fmt.Sprintf(`%#v`, spec[0]), spec[0].String()} {
+		if strings.Contains(dump, "s3cr3t") || ! strings.Contains(dump, secretMask) {
+			t.Errorf(`Unexpected dump: %s`, dump)
+		}
+	}
+	if dump := spec[1].String(); ! strings.Contains(dump, "admin") {
+		t.Errorf(`Unexpected dump: %s`, dump)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_SecretKo(t *testing.T)  {
+	type testSet struct {
+		spec func() Spec
+		input []string
+	}
+
+	var cloPassword string
+	var cloPin int
+	var cloKey string
+	var cloFilter regexp.Regexp
+	var cloVault *os.File
+
+	tests := []testSet{
+		{spec: func() Spec { return Spec{ Option{Long: "password", Holder: &cloPassword, Secret: true, MinLength: 10} } },
+			input: []string{"--password", "s3cr3t"}},
+		{spec: func() Spec { return Spec{ Option{Long: "password", Holder: &cloPassword, Secret: true, Choices: []string{"a", "b"}} } },
+			input: []string{"--password", "s3cr3t"}},
+		{spec: func() Spec { return Spec{ Option{Long: "password", Holder: &cloPassword, Secret: true, Pattern: `^[0-9]+$`} } },
+			input: []string{"--password", "s3cr3t"}},
+		{spec: func() Spec { return Spec{ Option{Long: "pin", Holder: &cloPin, Secret: true} } },
+			input: []string{"--pin", "s3cr3t"}},
+		{spec: func() Spec { return Spec{ Option{Long: "pin", Holder: &cloPin, Secret: true, Max: 10} } },
+			input: []string{"--pin", "12345"}},
+		{spec: func() Spec { return Spec{ Option{Long: "key", Holder: &cloKey, Secret: true,
+			Validate: func(v interface{}) error { return fmt.Errorf(`too weak`) }} } },
+			input: []string{"--key", "s3cr3t"}},
+		{spec: func() Spec { return Spec{ Option{Long: "filter", Holder: &cloFilter, Secret: true} } },
+			input: []string{"--filter", "(s3cr3t"}},
+		{spec: func() Spec { return Spec{ Option{Long: "vault", Holder: &cloVault, Secret: true} } },
+			input: []string{"--vault", "/nonexistent/s3cr3t"}},
+	}
+
+	for i, set := range tests {
+		_, _, err := Parse(set.input, set.spec())
+		if nil == err {
+			t.Errorf(`Test #%d failed! An error was expected.`, i)
+			continue
+		}
+		if strings.Contains(err.Error(), set.input[1]) || ! strings.Contains(err.Error(), secretMask) {
+			t.Errorf(`Test #%d failed! Unexpected error: %s`, i, err.Error())
+		}
+	}
+}
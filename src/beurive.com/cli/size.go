@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// This type represents a unit of size.
+
+type sizeUnit struct {
+	suffix string
+	factor uint64
+}
+
+// The list of units of size. SI units are powers of 1000. IEC units are powers of 1024.
+// Please note that the suffix "B" (for bytes) is optional within the command line.
+
+var sizeUnits = []sizeUnit{
+	{suffix: `B`,   factor: 1},
+	{suffix: `kB`,  factor: 1000},
+	{suffix: `MB`,  factor: 1000 * 1000},
+	{suffix: `GB`,  factor: 1000 * 1000 * 1000},
+	{suffix: `TB`,  factor: 1000 * 1000 * 1000 * 1000},
+	{suffix: `PB`,  factor: 1000 * 1000 * 1000 * 1000 * 1000},
+	{suffix: `EB`,  factor: 1000 * 1000 * 1000 * 1000 * 1000 * 1000},
+	{suffix: `KiB`, factor: 1 << 10},
+	{suffix: `MiB`, factor: 1 << 20},
+	{suffix: `GiB`, factor: 1 << 30},
+	{suffix: `TiB`, factor: 1 << 40},
+	{suffix: `PiB`, factor: 1 << 50},
+	{suffix: `EiB`, factor: 1 << 60},
+}
+
+// Return the factor associated to a unit of size, as it appears within the command line (ex: "k", "Mi" or "GiB").
+// The comparison is case insensitive.
+
+func getSizeFactor(inUnit string) (uint64, bool) {
+	u := strings.ToLower(strings.TrimSuffix(strings.TrimSuffix(inUnit, `B`), `b`))
+	for _, unit := range sizeUnits {
+		if strings.ToLower(strings.TrimSuffix(unit.suffix, `B`)) == u {
+			return unit.factor, true
+		}
+	}
+	return 0, false
+}
+
+// Convert a size (ex: "512MiB", "4k" or "1.5GB") into a number of bytes.
+// The size must fit within an integer which size (in bits) is given by the parameter inBitSize. The parameter inSigned
+// indicates whether this integer is signed or not.
+
+func (o *Option) parseSize(inValue string, inBitSize int, inSigned bool) (*big.Int, error) {
+	var rx *regexp.Regexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)$`)
+	m := rx.FindStringSubmatch(strings.TrimSpace(inValue))
+	if nil == m {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueSizeExpected, o.displayValue(inValue), o.getName()))
+	}
+	factor, ok := getSizeFactor(m[2])
+	if ! ok {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueSizeExpected, o.displayValue(inValue), o.getName()))
+	}
+
+	size, _ := new(big.Rat).SetString(m[1])
+	size.Mul(size, new(big.Rat).SetUint64(factor))
+	if ! size.IsInt() {
+		// A size cannot represent a fraction of byte (ex: "1.5B").
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueSizeExpected, o.displayValue(inValue), o.getName()))
+	}
+
+	if 0 == inBitSize { inBitSize = strconv.IntSize }
+	if inSigned { inBitSize-- }
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(inBitSize)), big.NewInt(1))
+	if size.Num().Cmp(max) > 0 {
+		return nil, errors.New(fmt.Sprintf(errorInvalidValueSizeTooLarge, o.displayValue(inValue), o.getName(), FormatSize(max.Uint64())))
+	}
+	return size.Num(), nil
+}
+
+// Format a number of bytes into a human-readable size (ex: 536870912 gives "512MiB" and 4000 gives "4kB").
+// The function selects the unit that gives the smallest exact number. Thus, the returned string can be used as a value
+// for an option which kind is KindSize.
+
+func FormatSize(inSize uint64) string {
+	best := sizeUnits[0]
+	for _, unit := range sizeUnits {
+		if 0 == inSize % unit.factor && inSize / unit.factor < inSize / best.factor {
+			best = unit
+		}
+	}
+	return fmt.Sprintf(`%d%s`, inSize / best.factor, best.suffix)
+}
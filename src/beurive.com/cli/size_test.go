@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+)
+
+// -----------------------------------------------------------------
+// Test the conversion of sizes.
+// -----------------------------------------------------------------
+
+func TestSizeOk(t *testing.T)  {
+	var cloMax uint64
+
+	type setType struct {
+		value    string
+		expected uint64
+	}
+
+	testSet := []setType{
+		{ value: "512",      expected: 512 },
+		{ value: "512B",     expected: 512 },
+		{ value: "4k",       expected: 4000 },
+		{ value: "4K",       expected: 4000 },
+		{ value: "4kB",      expected: 4000 },
+		{ value: "4Ki",      expected: 4096 },
+		{ value: "512MiB",   expected: 512 * 1024 * 1024 },
+		{ value: "1.5GB",    expected: 1500000000 },
+		{ value: "1.5 Gi",   expected: 1536 * 1024 * 1024 },
+		{ value: "15EiB",    expected: 15 << 60 },
+	}
+
+	for i, set := range testSet {
+		o := Option{Long: "max", Holder: &cloMax, Kind: KindSize}
+		if err := o.addValue(set.value); nil != err {
+			t.Errorf(`Test #%d failed. Unexpected error: %s`, i, err.Error())
+		} else if set.expected != cloMax {
+			t.Errorf(`Test #%d failed. Got %d, expected %d.`, i, cloMax, set.expected)
+		}
+	}
+}
+
+func TestSizeListOk(t *testing.T)  {
+	var cloBuffers []int32
+
+	spec := Spec{ Option{Short: "b", Long: "buf", Holder: &cloBuffers, Kind: KindSize, Max: 1 << 20} }
+	if _, _, err := Parse([]string{"-b", "4k", "--buf", "1MiB"}, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if 2 != len(cloBuffers) || 4000 != cloBuffers[0] || 1 << 20 != cloBuffers[1] {
+		t.Errorf(`Unexpected values: %v.`, cloBuffers)
+	}
+}
+
+func TestFormatSize(t *testing.T)  {
+	testSet := map[uint64]string{
+		0:                  "0B",
+		512:                "512B",
+		4000:               "4kB",
+		4096:               "4KiB",
+		536870912:          "512MiB",
+		1500000000:         "1500MB",
+		4096000:            "4000KiB",
+		1 << 60:            "1EiB",
+		18446744073709551615: "18446744073709551615B",
+	}
+
+	for size, expected := range testSet {
+		if got := FormatSize(size); got != expected {
+			t.Errorf(`FormatSize(%d) failed. Got "%s", expected "%s".`, size, got, expected)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValueSize(t *testing.T)  {
+	var cloMax uint64
+	var cloBuf uint8
+	var cloLimit int16
+
+	type setType struct {
+		option Option
+		value string
+		expected string
+	}
+	testSet := []setType{
+		{
+			option: Option{Long: "max", Holder: &cloMax, Kind: KindSize},
+			value: "12XB",
+			expected: fmt.Sprintf(errorInvalidValueSizeExpected, "12XB", "max"),
+		},
+		{
+			option: Option{Long: "max", Holder: &cloMax, Kind: KindSize},
+			value: "1.5B",
+			expected: fmt.Sprintf(errorInvalidValueSizeExpected, "1.5B", "max"),
+		},
+		{
+			option: Option{Long: "max", Holder: &cloMax, Kind: KindSize},
+			value: "16EiB",
+			expected: fmt.Sprintf(errorInvalidValueSizeTooLarge, "16EiB", "max", "18446744073709551615B"),
+		},
+		{
+			option: Option{Long: "buf", Holder: &cloBuf, Kind: KindSize},
+			value: "1k",
+			expected: fmt.Sprintf(errorInvalidValueSizeTooLarge, "1k", "buf", "255B"),
+		},
+		{
+			option: Option{Long: "limit", Holder: &cloLimit, Kind: KindSize},
+			value: "32Ki",
+			expected: fmt.Sprintf(errorInvalidValueSizeTooLarge, "32Ki", "limit", "32767B"),
+		},
+	}
+
+	for i, set := range testSet {
+		if err := set.option.addValue(set.value); nil == err {
+			t.Errorf(`Test #%d should fail!`, i)
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
+
+func TestEM_SizeUnexpectedHolderType(t *testing.T)  {
+	var cloMax float64
+
+	o := Option{Short: "m", Long: "max", Holder: &cloMax, Kind: KindSize}
+	if err := o.init(); nil == err {
+		t.Error("Option's specifier should not be valid!")
+	} else {
+		if 0 != strings.Compare(err.Error(), errorSizeUnexpectedHolderType) {
+			t.Errorf(`Test failed. Got [%s] / [%s]`, err.Error(), errorSizeUnexpectedHolderType)
+		}
+	}
+}
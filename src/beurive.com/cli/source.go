@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// The default maximum size (in bytes) of a value read from a file.
+
+const defaultMaxFileSize = 1024 * 1024
+
+// The reader used to read values from the standard input. It can be replaced for testing purposes.
+
+var stdin io.Reader = os.Stdin
+
+// Return the maximum size (in bytes) of a value read from a file.
+
+func (o *Option) getMaxFileSize() int64 {
+	if o.MaxFileSize > 0 { return o.MaxFileSize }
+	return defaultMaxFileSize
+}
+
+// Return the long name of the paired "-file" option associated to an option (ex: "token-file" for "token").
+
+func (o *Option) getFileOptionName() string {
+	return o.Long + `-file`
+}
+
+// Check that the ways of reading the values of an option from files are valid:
+// - Values can be read from files only for options that take values.
+// - A paired "-file" option requires a long name.
+
+func (o *Option) initSource() error {
+	if "" == o.FileMarker && ! o.FileOption { return nil }
+
+	if ! o.requireValue() {
+		return errors.New(errorFileSourceUnexpectedHolderType)
+	}
+	if o.FileOption && "" == o.Long {
+		return errors.New(errorFileOptionWithoutLongName)
+	}
+	return nil
+}
+
+// Read the value of an option from a file. The path "-" represents the standard input.
+// The size of the file must not exceed the option's maximum file size. Trailing newlines are removed.
+
+func (o *Option) readValueFile(inPath string) (string, error) {
+	var reader io.Reader
+	if `-` == inPath {
+		reader = stdin
+	} else {
+		f, err := os.Open(inPath)
+		if nil != err {
+			return "", errors.New(fmt.Sprintf(errorInvalidValueFileRead, o.getName(), inPath, err.Error()))
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	// Read one more byte than allowed, so we know whether the limit is exceeded.
+	limit := o.getMaxFileSize()
+	data, err := io.ReadAll(io.LimitReader(reader, limit + 1))
+	if nil != err {
+		return "", errors.New(fmt.Sprintf(errorInvalidValueFileRead, o.getName(), inPath, err.Error()))
+	}
+	if int64(len(data)) > limit {
+		return "", errors.New(fmt.Sprintf(errorInvalidValueFileTooLarge, o.getName(), inPath, limit))
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// Return the actual value of an option, given the value that appears within the command line.
+// The value is read from a file if:
+// - the option has been introduced by its paired "-file" option (parameter inFromFile), or
+// - the value starts with the option's file marker (ex: "@/path/to/file" or "@-" for the standard input).
+// Otherwise, the function returns the given value.
+
+func (o *Option) resolveValue(inValue string, inFromFile bool) (string, error) {
+	if inFromFile {
+		return o.readValueFile(inValue)
+	}
+	if "" != o.FileMarker && strings.HasPrefix(inValue, o.FileMarker) {
+		return o.readValueFile(strings.TrimPrefix(inValue, o.FileMarker))
+	}
+	return inValue, nil
+}
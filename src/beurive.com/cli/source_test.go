@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// -----------------------------------------------------------------
+// Test the reading of values from files.
+// -----------------------------------------------------------------
+
+func TestSourceOk(t *testing.T)  {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("s3cr3t\r\n"), 0600); nil != err {
+		t.Fatal(err)
+	}
+	passwordFile := filepath.Join(dir, "password")
+	if err := os.WriteFile(passwordFile, []byte("p@ss\n"), 0600); nil != err {
+		t.Fatal(err)
+	}
+
+	defer func() { stdin = os.Stdin }()
+	stdin = strings.NewReader("42\n")
+
+	var cloToken string
+	var cloPassword string
+	var cloRetries int
+	var cloUser string
+
+	spec := Spec{
+		Option{Short: "t", Long: "token",    Holder: &cloToken,    FileMarker: "@"},
+		Option{Short: "p", Long: "password", Holder: &cloPassword, FileOption: true},
+		Option{Short: "r", Long: "retries",  Holder: &cloRetries,  FileMarker: "@"},
+		Option{Short: "u", Long: "user",     Holder: &cloUser},
+	}
+
+	input := []string{"-t", "@" + tokenFile, "--password-file", passwordFile, "--retries", "@-", "--user", "@admin"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Fatalf(`Unexpected error: %s`, err.Error())
+	}
+	if "s3cr3t" != cloToken || "p@ss" != cloPassword || 42 != cloRetries || "@admin" != cloUser {
+		t.Errorf(`Unexpected values: "%s", "%s", %d, "%s".`, cloToken, cloPassword, cloRetries, cloUser)
+	}
+
+	// The option can still be given directly.
+	cloPassword = ""
+	spec = Spec{ Option{Short: "p", Long: "password", Holder: &cloPassword, FileOption: true} }
+	if _, _, err := Parse([]string{"--password", "direct"}, spec); nil != err || "direct" != cloPassword {
+		t.Errorf(`Unexpected result: "%s", %v.`, cloPassword, err)
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValueFile(t *testing.T)  {
+	dir := t.TempDir()
+	bigFile := filepath.Join(dir, "big")
+	if err := os.WriteFile(bigFile, []byte("0123456789"), 0600); nil != err {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing")
+
+	var cloToken string
+
+	type setType struct {
+		spec Spec
+		input []string
+		expected string
+	}
+	testSet := []setType{
+		{
+			spec: Spec{ Option{Short: "t", Long: "token", Holder: &cloToken, FileMarker: "@", MaxFileSize: 8} },
+			input: []string{ "--token", "@" + bigFile },
+			expected: fmt.Sprintf(errorInvalidValueFileTooLarge, "token", bigFile, 8),
+		},
+		{
+			spec: Spec{ Option{Short: "t", Long: "token", Holder: &cloToken, FileOption: true} },
+			input: []string{ "--token-file", missing },
+			expected: fmt.Sprintf(errorInvalidValueFileRead, "token", missing, fmt.Sprintf("open %s: no such file or directory", missing)),
+		},
+		{
+			spec: Spec{ Option{Short: "t", Long: "token", Holder: &cloToken, FileOption: true} },
+			input: []string{ "--token", "a", "--token-file", bigFile },
+			expected: fmt.Sprintf(errorDuplicatedNonFlagOption, "token-file"),
+		},
+	}
+
+	for i, set := range testSet {
+		if _, _, err := Parse(set.input, set.spec); nil == err {
+			t.Errorf(`The test number %d should NOT be OK (%s)`, i, strings.Join(set.input, " "))
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
+
+func TestEM_InvalidSourceDefinition(t *testing.T)  {
+	var cloVerbose bool
+	var cloToken string
+	var cloTokenFile string
+
+	type setType struct {
+		spec Spec
+		expected string
+	}
+	testSet := []setType{
+		{
+			spec: Spec{ Option{Short: "v", Long: "verbose", Holder: &cloVerbose, FileMarker: "@"} },
+			expected: fmt.Sprintf(errorInvalidCmdLineSpecInvalidOptionDefinition, 0, errorFileSourceUnexpectedHolderType),
+		},
+		{
+			spec: Spec{ Option{Short: "t", Long: "", Holder: &cloToken, FileOption: true} },
+			expected: fmt.Sprintf(errorInvalidCmdLineSpecInvalidOptionDefinition, 0, errorFileOptionWithoutLongName),
+		},
+		{
+			spec: Spec{
+				Option{Short: "t", Long: "token", Holder: &cloToken, FileOption: true},
+				Option{Short: "f", Long: "token-file", Holder: &cloTokenFile},
+			},
+			expected: fmt.Sprintf(errorInvalidCmdLineSpecDuplicatedFileOption, 0, "token-file"),
+		},
+	}
+
+	for i, set := range testSet {
+		if _, err := set.spec.init(); nil == err {
+			t.Errorf(`Test #%d: the specification should not be valid!`, i)
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+		}
+	}
+}
@@ -1,49 +1,75 @@
-package cli
-
-import (
-	"errors"
-	"fmt"
-)
-
-// This structure represents the command line specification.
-
-type Spec []Option
-
-
-// Initialise the specification. This operation involves the following actions:
-// - Check the specification (option names, holder types and holders singleness).
-// - Build an index. Options are organised according to their length (short or long) and their names.
-
-func (s Spec) init() (*specIndex, error) {
-	shorts := make(map[string]*Option)
-	longs := make(map[string]*Option)
-	holders := make(map[interface{}]int)
-
-	for i := range s {
-		option := &s[i]
-		if err := option.init(); nil != err {
-			return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecInvalidOptionDefinition, i, err.Error()))
-		}
-		if "" != option.Short {
-			if _, exists := shorts[option.Short]; exists {
-				return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecDuplicatedShortNamedOption, i, option.Short))
-			}
-			shorts[option.Short] = option
-		}
-		if "" != option.Long {
-			if _, exists := longs[option.Long]; exists {
-				return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecDuplicatedLongNamedOption, i, option.Long))
-			}
-			longs[option.Long] = option
-		}
-		// Sanity check: make sure that the same variable is not used to store values for different options.
-		if _, exists := holders[option.Holder]; ! exists {
-			holders[option.Holder] = i
-		} else {
-			return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecReuseOfValueHolder, i))
-		}
-
-	}
-	return &specIndex{Short: shorts, Long: longs }, nil
-}
-
+package cli
+
+import (
+	"errors"
+	"fmt"
+)
+
+// This structure represents the command line specification.
+
+type Spec []Option
+
+
+// Initialise the specification. This operation involves the following actions:
+// - Check the specification (option names, holder types and holders singleness).
+// - Build an index. Options are organised according to their length (short or long) and their names. Paired "-file"
+//   options (see the attribute "FileOption") are indexed separately.
+
+func (s Spec) init() (*specIndex, error) {
+	shorts := make(map[string]*Option)
+	longs := make(map[string]*Option)
+	files := make(map[string]*Option)
+	holders := make(map[interface{}]int)
+
+	for i := range s {
+		option := &s[i]
+		if err := option.init(); nil != err {
+			return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecInvalidOptionDefinition, i, err.Error()))
+		}
+		if "" != option.Short {
+			if _, exists := shorts[option.Short]; exists {
+				return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecDuplicatedShortNamedOption, i, option.Short))
+			}
+			shorts[option.Short] = option
+		}
+		if "" != option.Long {
+			if _, exists := longs[option.Long]; exists {
+				return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecDuplicatedLongNamedOption, i, option.Long))
+			}
+			longs[option.Long] = option
+		}
+		// Sanity check: make sure that the same variable is not used to store values for different options.
+		if _, exists := holders[option.Holder]; ! exists {
+			holders[option.Holder] = i
+		} else {
+			return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecReuseOfValueHolder, i))
+		}
+
+	}
+
+	// Paired "-file" options must not conflict with long options.
+	for i := range s {
+		option := &s[i]
+		if ! option.FileOption { continue }
+		name := option.getFileOptionName()
+		if _, exists := longs[name]; exists {
+			return nil, errors.New(fmt.Sprintf(errorInvalidCmdLineSpecDuplicatedFileOption, i, name))
+		}
+		files[name] = option
+	}
+	return &specIndex{Short: shorts, Long: longs, Files: files }, nil
+}
+
+
+// Return the option identified by a given name. The name may be a long name or a short name (ex: "input" or "i").
+// Long names are searched first. If no option matches the given name, then the function returns the value nil.
+
+func (s Spec) Lookup(inName string) *Option {
+	for i := range s {
+		if inName == s[i].Long { return &s[i] }
+	}
+	for i := range s {
+		if inName == s[i].Short { return &s[i] }
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Return the placeholder that represents the value of an option within the usage (ex: "<input>"). The placeholder is
+// made of the long name of the option, if it exists.
+
+func (o *Option) getPlaceholder() string {
+	if "" != o.Long { return fmt.Sprintf(`<%s>`, o.Long) }
+	return `<value>`
+}
+
+// Return the representation of an option within the synopsis of a command (ex: "--verbose", "--input <input>" or
+// "-t <value>..."). The suffix "..." means that the option may appear more than once. For rest-of-line options, the
+// suffix "..." means that the option takes several values, and the terminator follows (ex: "--exec <exec>... ;").
+
+func (o *Option) getUsage() string {
+	usage := o.getSpecifier()
+	if KindRest == o.Kind {
+		usage += ` ` + o.getPlaceholder() + `...`
+		if "" != o.Terminator { usage += ` ` + o.Terminator }
+		return usage
+	}
+	if o.requireValue() { usage += ` ` + o.getPlaceholder() }
+	if ! o.isSingleton() { usage += `...` }
+	return usage
+}
+
+// Return the representation of a group of options within the synopsis of a command:
+// - GroupExclusive: "[--json | --yaml]"
+// - GroupAtLeastOne: "(--tag <tag> | --all)..."
+// - GroupExactlyOne: "(--file <file> | --url <url>)"
+// - GroupAllOrNone: "[--user <user> --password <password>]"
+
+func (g Group) getUsage(inOptions []*Option) string {
+	elements := make([]string, len(inOptions))
+	for i, o := range inOptions {
+		elements[i] = o.getUsage()
+	}
+	switch g.Kind {
+		case GroupExclusive:
+			return fmt.Sprintf(`[%s]`, strings.Join(elements, ` | `))
+		case GroupAtLeastOne:
+			return fmt.Sprintf(`(%s)...`, strings.Join(elements, ` | `))
+		case GroupExactlyOne:
+			return fmt.Sprintf(`(%s)`, strings.Join(elements, ` | `))
+	}
+	return fmt.Sprintf(`[%s]`, strings.Join(elements, ` `))
+}
+
+// Return the synopsis of a command, given its name, its specification and its directives.
+// Options are listed in the order of the specification. Optional options (that are neither required nor required to
+// appear a minimum number of times) are written between brackets. The options of a group are written together, at the
+// position of the first option of the group (ex: "[--json | --yaml]"). Groups that are not valid are ignored.
+// Positional arguments (see the type Arguments) are written after the options (ex: "<src> [<files>...]").
+//
+// Example: "prg [-v] --input <input> [--json | --yaml] <src> [<files>...]"
+
+func Synopsis(inProgram string, inSpec Spec, inDirectives ...Directive) string {
+	// Find the group of each option (if any).
+	groups := make(map[*Option]Group)
+	members := make(map[*Option][]*Option)
+	for _, directive := range inDirectives {
+		g, ok := directive.(Group)
+		if ! ok { continue }
+		options, err := g.getOptions(inSpec)
+		if nil != err { continue }
+		for _, o := range options {
+			if _, exists := groups[o]; exists { continue }
+			groups[o] = g
+			members[o] = options
+		}
+	}
+
+	elements := []string{inProgram}
+	rendered := make(map[*Option]bool)
+	for i := range inSpec {
+		o := &inSpec[i]
+		if rendered[o] { continue }
+		if g, ok := groups[o]; ok {
+			elements = append(elements, g.getUsage(members[o]))
+			for _, m := range members[o] { rendered[m] = true }
+			continue
+		}
+		if o.Required || o.MinOccurrences > 0 {
+			elements = append(elements, o.getUsage())
+		} else {
+			elements = append(elements, fmt.Sprintf(`[%s]`, o.getUsage()))
+		}
+		rendered[o] = true
+	}
+
+	// The positional arguments follow the options.
+	for _, directive := range inDirectives {
+		arguments, ok := directive.(Arguments)
+		if ! ok { continue }
+		for i := range arguments {
+			elements = append(elements, arguments[i].getUsage())
+		}
+	}
+	return strings.Join(elements, ` `)
+}
+
+// Return the usage of a command, given its name, its specification and its directives. The usage is made of the
+// synopsis of the command (see the function Synopsis), followed by the list of options. For each option, the usage
+// indicates whether the option is required or not, and the default value of the option (if any).
+//
+// Example:
+//
+//     Usage: prg [-v] --input <input> [-r <retries>]
+//
+//     Options:
+//       -v, --verbose
+//       -i, --input <input>       (required)
+//       -r, --retries <retries>   (default: 3)
+
+func Usage(inProgram string, inSpec Spec, inDirectives ...Directive) string {
+	lines := []string{fmt.Sprintf(`Usage: %s`, Synopsis(inProgram, inSpec, inDirectives...))}
+	if 0 == len(inSpec) { return lines[0] + "\n" }
+
+	names := make([]string, len(inSpec))
+	width := 0
+	for i := range inSpec {
+		o := &inSpec[i]
+		specifiers := make([]string, 0, 2)
+		if "" != o.Short { specifiers = append(specifiers, `-` + o.Short) }
+		if "" != o.Long { specifiers = append(specifiers, `--` + o.Long) }
+		names[i] = strings.Join(specifiers, `, `)
+		if o.requireValue() { names[i] += ` ` + o.getPlaceholder() }
+		if len(names[i]) > width { width = len(names[i]) }
+	}
+
+	lines = append(lines, ``, `Options:`)
+	for i := range inSpec {
+		o := &inSpec[i]
+		notes := make([]string, 0)
+		if o.Required || o.MinOccurrences > 0 { notes = append(notes, `required`) }
+		if nil != o.Default { notes = append(notes, fmt.Sprintf(`default: %s`, o.DefaultString())) }
+		if 0 == len(notes) {
+			lines = append(lines, `  ` + names[i])
+			continue
+		}
+		lines = append(lines, fmt.Sprintf(`  %-*s   (%s)`, width, names[i], strings.Join(notes, `, `)))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"testing"
+)
+
+// -----------------------------------------------------------------
+// Test the synopsis and the usage.
+// -----------------------------------------------------------------
+
+func TestSynopsisOk(t *testing.T)  {
+	var cloVerbose bool
+	var cloInput string
+	var cloJSON bool
+	var cloYAML bool
+	var cloTags []string
+	var cloFile string
+	var cloURL string
+	var cloUser string
+	var cloPassword string
+
+	spec := Spec{
+		Option{Short: "v", Long: "verbose", Holder: &cloVerbose},
+		Option{Short: "i", Long: "input",   Holder: &cloInput, Required: true},
+		Option{Long: "json",                Holder: &cloJSON},
+		Option{Long: "yaml",                Holder: &cloYAML},
+		Option{Short: "t",                  Holder: &cloTags},
+		Option{Long: "file",                Holder: &cloFile},
+		Option{Long: "url",                 Holder: &cloURL},
+		Option{Long: "user",                Holder: &cloUser},
+		Option{Long: "password",            Holder: &cloPassword},
+	}
+
+	type testSet struct {
+		directives []Directive
+		expected string
+	}
+
+	for i, set := range []testSet{
+		{expected: `prg [--verbose] --input <input> [--json] [--yaml] [-t <value>...] [--file <file>] [--url <url>] [--user <user>] [--password <password>]`},
+		{directives: []Directive{
+			Group{Kind: GroupExclusive,  Options: []string{"json", "yaml"}},
+			Group{Kind: GroupExactlyOne, Options: []string{"file", "url"}},
+			Group{Kind: GroupAllOrNone,  Options: []string{"user", "password"}},
+			Group{Kind: GroupAtLeastOne, Options: []string{"t", "verbose"}},
+		}, expected: `prg (-t <value>... | --verbose)... --input <input> [--json | --yaml] (--file <file> | --url <url>) [--user <user> --password <password>]`},
+		{directives: []Directive{
+			Group{Kind: GroupExclusive,  Options: []string{"json", "xml"}},
+			Validator(func(inSpec Spec) error { return nil }),
+		}, expected: `prg [--verbose] --input <input> [--json] [--yaml] [-t <value>...] [--file <file>] [--url <url>] [--user <user>] [--password <password>]`},
+		{directives: []Directive{
+			Arguments{
+				{Name: "src", Holder: &cloFile},
+				{Name: "dst", Holder: &cloURL, Optional: true},
+				{Name: "tags", Holder: &cloTags, Variadic: true, Optional: true},
+			},
+		}, expected: `prg [--verbose] --input <input> [--json] [--yaml] [-t <value>...] [--file <file>] [--url <url>] [--user <user>] [--password <password>] <src> [<dst>] [<tags>...]`},
+	} {
+		if got := Synopsis("prg", spec, set.directives...); set.expected != got {
+			t.Errorf("Test #%d failed!\nGot:      %s\nExpected: %s", i, got, set.expected)
+		}
+	}
+}
+
+func TestUsageOk(t *testing.T)  {
+	var cloVerbose bool
+	var cloInput string
+	var cloRetries int
+	var cloToken string
+	var cloHosts []string
+
+	spec := Spec{
+		Option{Short: "v", Long: "verbose", Holder: &cloVerbose},
+		Option{Short: "i", Long: "input",   Holder: &cloInput, Required: true},
+		Option{Short: "r", Long: "retries", Holder: &cloRetries, Default: 3},
+		Option{Long: "token",               Holder: &cloToken, Default: "s3cr3t", Secret: true},
+		Option{Long: "host",                Holder: &cloHosts, MinOccurrences: 1},
+	}
+
+	expected := `Usage: prg [--verbose] --input <input> [--retries <retries>] [--token <token>] --host <host>...
+
+Options:
+  -v, --verbose
+  -i, --input <input>       (required)
+  -r, --retries <retries>   (default: 3)
+  --token <token>           (default: ******)
+  --host <host>             (required)
+`
+	if got := Usage("prg", spec); expected != got {
+		t.Errorf("Unexpected usage!\nGot:\n%s\nExpected:\n%s", got, expected)
+	}
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// The type OptionError represents an error that concerns a specific option.
+// * The attribute "Name" contains the name of the option (the long name if it exists, the short name otherwise).
+// * The attribute "Value" contains the value of the option, as it appears within the command line.
+// * The attribute "Err" contains the reason of the failure.
+
+type OptionError struct {
+	Name string   // The option's name.
+	Value string  // The option's value, as it appears within the command line.
+	Err error     // The reason of the failure.
+}
+
+func (e *OptionError) Error() string {
+	return fmt.Sprintf(errorInvalidValueRejected, e.Value, e.Name, e.Err.Error())
+}
+
+func (e *OptionError) Unwrap() error {
+	return e.Err
+}
+
+// Return the last value stored within an option's value holder.
+// If the holder stores a list of values, then the function returns the last element of the list. Otherwise, it
+// returns the value pointed by the holder. Arbitrary precision numbers and regular expressions are always returned as
+// pointers (ex: *big.Int or *regexp.Regexp).
+
+func (o *Option) lastValue() interface{} {
+	if f := o.getFamily(); (familyBig == f || familyRegexp == f) && o.isSingleton() { return o.Holder }
+	v := reflect.ValueOf(o.Holder).Elem()
+	if ! o.isSingleton() && reflect.Slice == v.Kind() && v.Len() > 0 {
+		return v.Index(v.Len() - 1).Interface()
+	}
+	return v.Interface()
+}
+
+// Apply the option's validator (if any) to the last value stored within the option's value holder.
+// The parameter inValue represents the value as it appears within the command line. It is used to build the error.
+
+func (o *Option) checkValidator(inValue string) error {
+	if nil == o.Validate { return nil }
+
+	if err := o.Validate(o.lastValue()); nil != err {
+		return &OptionError{Name: o.getName(), Value: o.displayValue(inValue), Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"testing"
+	"strings"
+	"errors"
+	"fmt"
+)
+
+// -----------------------------------------------------------------
+// Test the per-option validators.
+// -----------------------------------------------------------------
+
+func TestValidateOk(t *testing.T)  {
+	var cloPort int
+	var cloTags []string
+	var cloVerbose bool
+	var received []interface{}
+
+	validate := func(inValue interface{}) error {
+		received = append(received, inValue)
+		return nil
+	}
+
+	spec := Spec{
+		Option{Short: "p", Long: "port",    Holder: &cloPort,    Validate: validate},
+		Option{Short: "t", Long: "tag",     Holder: &cloTags,    Validate: validate},
+		Option{Short: "v", Long: "verbose", Holder: &cloVerbose, Validate: validate},
+	}
+
+	input := []string{"-p", "8080", "-t", "a", "-v", "-t", "b"}
+	if _, _, err := Parse(input, spec); nil != err {
+		t.Errorf(`Unexpected error: %s`, err.Error())
+	}
+
+	expected := []interface{}{8080, "a", true, "b"}
+	if len(expected) != len(received) {
+		t.Fatalf(`Unexpected values received by the validator: %#v`, received)
+	}
+	for i, v := range expected {
+		if v != received[i] {
+			t.Errorf(`Test #%d failed. Got %#v, expected %#v.`, i, received[i], v)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the post-parse validation hooks.
+// -----------------------------------------------------------------
+
+func TestValidatorDirective(t *testing.T)  {
+	var cloStart int
+	var cloEnd int
+
+	spec := Spec{
+		Option{Short: "s", Long: "start", Holder: &cloStart},
+		Option{Short: "e", Long: "end",   Holder: &cloEnd},
+	}
+
+	afterStart := Validator(func(inSpec Spec) error {
+		start := inSpec.Lookup("start").Holder.(*int)
+		end := inSpec.Lookup("e").Holder.(*int)
+		if *end <= *start {
+			return &OptionError{Name: "end", Value: fmt.Sprintf(`%d`, *end), Err: errors.New(`must be after --start`)}
+		}
+		return nil
+	})
+
+	if _, _, err := Parse([]string{"--start", "1", "--end", "2"}, spec, afterStart); nil != err {
+		t.Errorf(`Unexpected error: %s`, err.Error())
+	}
+
+	cloStart, cloEnd = 0, 0
+	spec = Spec{
+		Option{Short: "s", Long: "start", Holder: &cloStart},
+		Option{Short: "e", Long: "end",   Holder: &cloEnd},
+	}
+	if _, _, err := Parse([]string{"--start", "2", "--end", "1"}, spec, afterStart); nil == err {
+		t.Error(`The test should fail!`)
+	} else {
+		var oe *OptionError
+		if ! errors.As(err, &oe) || "end" != oe.Name {
+			t.Errorf(`Unexpected error: %#v`, err)
+		}
+	}
+}
+
+// -----------------------------------------------------------------
+// Test the error messages.
+// -----------------------------------------------------------------
+
+func TestEM_InvalidValueRejected(t *testing.T)  {
+	var cloPort int
+	var cloTags []string
+	var cloVerbose bool
+
+	errBusy := errors.New(`port already in use`)
+	errReserved := errors.New(`reserved tag`)
+	errNoVerbose := errors.New(`verbose mode is disabled`)
+	noVerbose := func(inValue interface{}) error { return errNoVerbose }
+
+	type setType struct {
+		spec Spec
+		input []string
+		expected string
+		cause error
+	}
+	testSet := []setType{
+		{
+			spec: Spec{ Option{Short: "p", Long: "port", Holder: &cloPort, Validate: func(inValue interface{}) error {
+				if 80 == inValue.(int) { return errBusy }
+				return nil
+			}} },
+			input: []string{ "--port", "80" },
+			expected: fmt.Sprintf(errorInvalidValueRejected, "80", "port", errBusy.Error()),
+			cause: errBusy,
+		},
+		{
+			spec: Spec{ Option{Short: "t", Long: "", Holder: &cloTags, Validate: func(inValue interface{}) error {
+				if "root" == inValue.(string) { return errReserved }
+				return nil
+			}} },
+			input: []string{ "-t", "a", "-t", "root" },
+			expected: fmt.Sprintf(errorInvalidValueRejected, "root", "t", errReserved.Error()),
+			cause: errReserved,
+		},
+		{
+			spec: Spec{ Option{Short: "v", Long: "verbose", Holder: &cloVerbose, Validate: noVerbose} },
+			input: []string{ "--verbose" },
+			expected: fmt.Sprintf(errorInvalidValueRejected, "true", "verbose", errNoVerbose.Error()),
+			cause: errNoVerbose,
+		},
+		{
+			spec: Spec{ Option{Short: "v", Long: "verbose", Holder: &cloVerbose, Validate: noVerbose} },
+			input: []string{ "-v" },
+			expected: fmt.Sprintf(errorInvalidValueRejected, "true", "verbose", errNoVerbose.Error()),
+			cause: errNoVerbose,
+		},
+	}
+
+	for i, set := range testSet {
+		if _, _, err := Parse(set.input, set.spec); nil == err {
+			t.Errorf(`The test number %d should NOT be OK (%s)`, i, strings.Join(set.input, " "))
+		} else {
+			if 0 != strings.Compare(err.Error(), set.expected) {
+				t.Errorf(`Test #%d failed. Got [%s] / [%s]`, i, err.Error(), set.expected)
+			}
+			if ! errors.Is(err, set.cause) {
+				t.Errorf(`Test #%d failed. The error should wrap the validator's error.`, i)
+			}
+		}
+	}
+}